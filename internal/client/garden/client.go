@@ -99,6 +99,8 @@ type Client interface {
 	ListBastions(ctx context.Context, opts ...client.ListOption) (*operationsv1alpha1.BastionList, error)
 	// PatchBastion patches an existing bastion to match newBastion using the merge patch strategy
 	PatchBastion(ctx context.Context, newBastion, oldBastion *operationsv1alpha1.Bastion) error
+	// DeleteBastion deletes a bastion, it is not an error if the bastion does not exist
+	DeleteBastion(ctx context.Context, bastion *operationsv1alpha1.Bastion) error
 
 	CurrentUser(ctx context.Context) (string, error)
 
@@ -336,6 +338,14 @@ func (g *clientImpl) PatchBastion(ctx context.Context, newBastion, oldBastion *o
 	return g.c.Patch(ctx, newBastion, client.MergeFrom(oldBastion))
 }
 
+func (g *clientImpl) DeleteBastion(ctx context.Context, bastion *operationsv1alpha1.Bastion) error {
+	if err := g.c.Delete(ctx, bastion); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete bastion %s: %w", client.ObjectKeyFromObject(bastion), err)
+	}
+
+	return nil
+}
+
 func (g *clientImpl) CurrentUser(ctx context.Context) (string, error) {
 	rawConfig, err := g.config.RawConfig()
 	if err != nil {
@@ -57,6 +57,20 @@ func (mr *MockClientMockRecorder) CurrentUser(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentUser", reflect.TypeOf((*MockClient)(nil).CurrentUser), arg0)
 }
 
+// DeleteBastion mocks base method.
+func (m *MockClient) DeleteBastion(arg0 context.Context, arg1 *v1alpha1.Bastion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBastion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBastion indicates an expected call of DeleteBastion.
+func (mr *MockClientMockRecorder) DeleteBastion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBastion", reflect.TypeOf((*MockClient)(nil).DeleteBastion), arg0, arg1)
+}
+
 // FindShoot mocks base method.
 func (m *MockClient) FindShoot(arg0 context.Context, arg1 ...client.ListOption) (*v1beta1.Shoot, error) {
 	m.ctrl.T.Helper()
@@ -32,11 +32,12 @@ func NewProvider() Provider {
 }
 
 // FromClientConfig returns a Kubernetes client for the given client config.
+// The returned client also implements client.WithWatch.
 func (p *provider) FromClientConfig(clientConfig clientcmd.ClientConfig) (client.Client, error) {
 	config, err := clientConfig.ClientConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create restclient config: %w", err)
 	}
 
-	return client.New(config, client.Options{})
+	return client.NewWithWatch(config, client.Options{})
 }
@@ -8,14 +8,17 @@ package fake
 
 import (
 	"context"
+	"errors"
 	"reflect"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -32,7 +35,7 @@ type clientWrapper struct {
 	delegate client.Client
 }
 
-var _ client.Client = &clientWrapper{}
+var _ client.WithWatch = &clientWrapper{}
 
 func (w *clientWrapper) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
 	return w.delegate.Get(ctx, key, obj, opts...)
@@ -83,6 +86,21 @@ func (w *clientWrapper) List(ctx context.Context, list client.ObjectList, opts .
 				fieldSet[gardencore.ProjectNamespace] = reflect.Indirect(vNamespace).String()
 			}
 
+			return fieldSet
+		})
+	case *operationsv1alpha1.BastionList:
+		filterItems(list, fieldSelector, func(vItem reflect.Value) fields.Set {
+			fieldSet := fields.Set{}
+			vName := vItem.FieldByName("Name")
+			fieldSet["metadata.name"] = vName.String()
+			vSpec := vItem.FieldByName("Spec")
+			fieldSet["spec.shootRef.name"] = vSpec.FieldByName("ShootRef").FieldByName("Name").String()
+			vSeedName := vSpec.FieldByName("SeedName")
+
+			if !vSeedName.IsNil() {
+				fieldSet[gardencore.ShootSeedName] = reflect.Indirect(vSeedName).String()
+			}
+
 			return fieldSet
 		})
 	}
@@ -90,6 +108,15 @@ func (w *clientWrapper) List(ctx context.Context, list client.ObjectList, opts .
 	return nil
 }
 
+func (w *clientWrapper) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	delegate, ok := w.delegate.(client.WithWatch)
+	if !ok {
+		return nil, errors.New("delegate client does not support watch")
+	}
+
+	return delegate.Watch(ctx, list, opts...)
+}
+
 func (w *clientWrapper) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
 	return w.delegate.Create(ctx, obj, opts...)
 }
@@ -36,6 +36,9 @@ type Factory struct {
 	// Override the clock implementation. Will use a real clock if not set.
 	ClockImpl util.Clock
 
+	// Override the public IP addresses. Will return fixed documentation addresses if not set.
+	PublicIPsImpl func(ctx context.Context) ([]string, error)
+
 	// GardenHomeDirectory is the home directory for all gardenctl
 	// related files. While some files can be explicitly loaded from
 	// different locations, persistent cache files will always be placed
@@ -44,6 +47,10 @@ type Factory struct {
 
 	// GardenTempDirectory is the base directory for temporary data.
 	GardenTempDirectory string
+
+	// SessionDirectory is the session directory of the created manager.
+	// Will use the OS temp directory if not set.
+	SessionDirectory string
 }
 
 var _ util.Factory = &Factory{}
@@ -79,7 +86,10 @@ func (f *Factory) Manager() (target.Manager, error) {
 		return f.ManagerImpl, nil
 	}
 
-	sessionDir := os.TempDir()
+	sessionDir := f.SessionDirectory
+	if sessionDir == "" {
+		sessionDir = os.TempDir()
+	}
 
 	return target.NewManager(f.Config, f.TargetProviderImpl, f.ClientProviderImpl, sessionDir)
 }
@@ -104,7 +114,11 @@ func (f *Factory) Clock() util.Clock {
 	return f.ClockImpl
 }
 
-func (f *Factory) PublicIPs(_ context.Context) ([]string, error) {
+func (f *Factory) PublicIPs(ctx context.Context) ([]string, error) {
+	if f.PublicIPsImpl != nil {
+		return f.PublicIPsImpl(ctx)
+	}
+
 	return []string{"192.0.2.42", "2001:db8::8a2e:370:7334"}, nil
 }
 
@@ -17,6 +17,8 @@ import (
 	"regexp"
 	"strings"
 
+	"k8s.io/klog/v2"
+
 	internalclient "github.com/gardener/gardenctl-v2/internal/client"
 	"github.com/gardener/gardenctl-v2/pkg/config"
 	"github.com/gardener/gardenctl-v2/pkg/target"
@@ -101,7 +103,7 @@ func (f *FactoryImpl) Manager() (target.Manager, error) {
 		return nil, err
 	}
 
-	sessionsDirectory := filepath.Join(f.GardenTempDir(), "sessions")
+	sessionsDirectory := filepath.Join(f.GardenTempDir(), SessionsDirectoryName)
 
 	err = os.MkdirAll(sessionsDirectory, 0o700)
 	if err != nil {
@@ -126,6 +128,12 @@ func (f *FactoryImpl) Manager() (target.Manager, error) {
 		return nil, fmt.Errorf("failed to create session directory: %w", err)
 	}
 
+	if cfg.SessionAutoClean() {
+		if err := AutoCleanSessions(sessionsDirectory, sid, cfg.SessionTTL(), f.Clock().Now()); err != nil {
+			klog.Warningf("failed to clean up inactive sessions: %v", err)
+		}
+	}
+
 	targetProvider := target.NewTargetProvider(filepath.Join(sessionDirectory, "target.yaml"), f.targetFlags)
 	clientProvider := internalclient.NewProvider()
 
@@ -0,0 +1,320 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/term"
+)
+
+var (
+	// ErrNoTerminal is returned if an interactive selection is requested but no terminal is available.
+	ErrNoTerminal = errors.New("no terminal available for interactive selection")
+	// ErrSelectionAborted is returned if the user aborts an interactive selection.
+	ErrSelectionAborted = errors.New("selection aborted")
+)
+
+const (
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyBackspace = 8
+	keyLineFeed  = 10
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyEnter     = 13
+	keyCtrlU     = 21
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+const (
+	// defaultMaxVisibleItems is the default number of items rendered by the fuzzy finder.
+	defaultMaxVisibleItems = 10
+	// nonContiguousMatchPenalty is added to the score of matches where the query is not a substring of the item.
+	nonContiguousMatchPenalty = 1 << 16
+)
+
+// IsTerminal checks if the io.Reader is connected to a terminal.
+func IsTerminal(in io.Reader) bool {
+	file, ok := in.(*os.File)
+	if !ok {
+		return false
+	}
+
+	return term.IsTerminal(int(file.Fd()))
+}
+
+// Pick lets the user select one of the given items with an interactive fuzzy finder.
+// The terminal connected to ioStreams.In is switched to raw mode while the finder is shown,
+// the finder itself is rendered to ioStreams.ErrOut so that the standard output is not affected.
+func Pick(ioStreams IOStreams, prompt string, items []string) (string, error) {
+	file, ok := ioStreams.In.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return "", ErrNoTerminal
+	}
+
+	state, err := term.MakeRaw(int(file.Fd()))
+	if err != nil {
+		return "", fmt.Errorf("failed to switch terminal to raw mode: %w", err)
+	}
+
+	defer func() {
+		_ = term.Restore(int(file.Fd()), state)
+	}()
+
+	return NewFuzzyFinder(prompt, items).Run(ioStreams.In, ioStreams.ErrOut)
+}
+
+// FuzzyFinder is a minimal terminal user interface to select one item of a list.
+// The list is filtered incrementally with every key stroke.
+type FuzzyFinder struct {
+	// Prompt is printed in front of the query
+	Prompt string
+	// Items is the list of items to choose from
+	Items []string
+	// MaxVisibleItems is the maximum number of items that are rendered at once
+	MaxVisibleItems int
+
+	query    []rune
+	matches  []string
+	cursor   int
+	offset   int
+	rendered bool
+}
+
+// NewFuzzyFinder returns a new FuzzyFinder for the given items.
+func NewFuzzyFinder(prompt string, items []string) *FuzzyFinder {
+	return &FuzzyFinder{
+		Prompt:          prompt,
+		Items:           items,
+		MaxVisibleItems: defaultMaxVisibleItems,
+	}
+}
+
+// Run reads key strokes from in and renders the finder to out until an item is selected
+// or the selection is aborted. The caller is responsible to put the terminal into raw mode.
+func (f *FuzzyFinder) Run(in io.Reader, out io.Writer) (string, error) {
+	reader := bufio.NewReader(in)
+
+	f.filter()
+	f.render(out)
+
+	for {
+		r, _, err := reader.ReadRune()
+		if err != nil {
+			f.clear(out)
+
+			if errors.Is(err, io.EOF) {
+				return "", ErrSelectionAborted
+			}
+
+			return "", fmt.Errorf("failed to read input: %w", err)
+		}
+
+		switch r {
+		case keyEnter, keyLineFeed:
+			if len(f.matches) == 0 {
+				continue
+			}
+
+			f.clear(out)
+
+			return f.matches[f.cursor], nil
+		case keyCtrlC, keyCtrlD:
+			f.clear(out)
+			return "", ErrSelectionAborted
+		case keyEscape:
+			if !f.readEscapeSequence(reader) {
+				f.clear(out)
+				return "", ErrSelectionAborted
+			}
+		case keyBackspace, keyDelete:
+			if len(f.query) > 0 {
+				f.query = f.query[:len(f.query)-1]
+				f.filter()
+			}
+		case keyCtrlU:
+			f.query = nil
+			f.filter()
+		case keyCtrlN:
+			f.moveCursor(1)
+		case keyCtrlP:
+			f.moveCursor(-1)
+		default:
+			if unicode.IsPrint(r) {
+				f.query = append(f.query, r)
+				f.filter()
+			}
+		}
+
+		f.render(out)
+	}
+}
+
+// readEscapeSequence handles the arrow keys. It returns false if the escape key was pressed on its own.
+func (f *FuzzyFinder) readEscapeSequence(reader *bufio.Reader) bool {
+	if reader.Buffered() < 2 {
+		return false
+	}
+
+	seq, err := reader.Peek(2)
+	if err != nil || (seq[0] != '[' && seq[0] != 'O') {
+		return false
+	}
+
+	_, _ = reader.Discard(2)
+
+	switch seq[1] {
+	case 'A':
+		f.moveCursor(-1)
+	case 'B':
+		f.moveCursor(1)
+	}
+
+	return true
+}
+
+func (f *FuzzyFinder) moveCursor(delta int) {
+	if len(f.matches) == 0 {
+		return
+	}
+
+	f.cursor = (f.cursor + delta + len(f.matches)) % len(f.matches)
+
+	if f.cursor < f.offset {
+		f.offset = f.cursor
+	} else if f.cursor >= f.offset+f.maxVisibleItems() {
+		f.offset = f.cursor - f.maxVisibleItems() + 1
+	}
+}
+
+func (f *FuzzyFinder) maxVisibleItems() int {
+	if f.MaxVisibleItems <= 0 {
+		return defaultMaxVisibleItems
+	}
+
+	return f.MaxVisibleItems
+}
+
+func (f *FuzzyFinder) filter() {
+	f.matches = FuzzyFilter(string(f.query), f.Items)
+	f.cursor = 0
+	f.offset = 0
+}
+
+func (f *FuzzyFinder) render(out io.Writer) {
+	var b strings.Builder
+
+	if f.rendered {
+		b.WriteString("\r\x1b[J")
+	}
+
+	lines := []string{
+		f.Prompt + string(f.query),
+		fmt.Sprintf("  %d/%d", len(f.matches), len(f.Items)),
+	}
+
+	end := f.offset + f.maxVisibleItems()
+	if end > len(f.matches) {
+		end = len(f.matches)
+	}
+
+	for i := f.offset; i < end; i++ {
+		marker := "  "
+		if i == f.cursor {
+			marker = "> "
+		}
+
+		lines = append(lines, marker+StripUnsafe(f.matches[i]))
+	}
+
+	b.WriteString(strings.Join(lines, "\r\n"))
+	// move the cursor back to the end of the query line
+	fmt.Fprintf(&b, "\x1b[%dA\r", len(lines)-1)
+
+	if column := len([]rune(lines[0])); column > 0 {
+		fmt.Fprintf(&b, "\x1b[%dC", column)
+	}
+
+	fmt.Fprint(out, b.String())
+
+	f.rendered = true
+}
+
+func (f *FuzzyFinder) clear(out io.Writer) {
+	if f.rendered {
+		fmt.Fprint(out, "\r\x1b[J")
+	}
+}
+
+// FuzzyFilter returns the items that contain all characters of the query in the given order, ignoring case.
+// Items containing the query as a whole come first, followed by the items with the most compact match.
+func FuzzyFilter(query string, items []string) []string {
+	type match struct {
+		item  string
+		score int
+	}
+
+	needle := []rune(strings.ToLower(query))
+	matches := []match{}
+
+	for _, item := range items {
+		if score, ok := fuzzyScore(needle, []rune(strings.ToLower(item))); ok {
+			matches = append(matches, match{item: item, score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score < matches[j].score
+	})
+
+	result := make([]string, 0, len(matches))
+	for _, m := range matches {
+		result = append(result, m.item)
+	}
+
+	return result
+}
+
+// fuzzyScore returns a score for the match of needle in haystack, lower is better.
+func fuzzyScore(needle, haystack []rune) (int, bool) {
+	if len(needle) == 0 {
+		return 0, true
+	}
+
+	if index := strings.Index(string(haystack), string(needle)); index >= 0 {
+		return index, true
+	}
+
+	first, n := -1, 0
+
+	for i, r := range haystack {
+		if r != needle[n] {
+			continue
+		}
+
+		if first < 0 {
+			first = i
+		}
+
+		n++
+		if n == len(needle) {
+			// matches that are not contiguous always rank behind contiguous matches
+			return nonContiguousMatchPenalty + i - first, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,113 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+var _ = Describe("Fuzzy Finder", func() {
+	items := []string{"prod-shoot", "dev-shoot", "production", "shoot-dev"}
+
+	Describe("#FuzzyFilter", func() {
+		It("should return all items for an empty query", func() {
+			Expect(util.FuzzyFilter("", items)).To(Equal(items))
+		})
+
+		It("should rank substring matches before fuzzy matches", func() {
+			Expect(util.FuzzyFilter("dev", items)).To(Equal([]string{"dev-shoot", "shoot-dev"}))
+			Expect(util.FuzzyFilter("pdsh", items)).To(Equal([]string{"prod-shoot"}))
+			Expect(util.FuzzyFilter("prod", items)).To(Equal([]string{"prod-shoot", "production"}))
+		})
+
+		It("should ignore case", func() {
+			Expect(util.FuzzyFilter("PROD-S", items)).To(Equal([]string{"prod-shoot"}))
+		})
+
+		It("should return an empty list if nothing matches", func() {
+			Expect(util.FuzzyFilter("xyz", items)).To(BeEmpty())
+		})
+	})
+
+	Describe("#Run", func() {
+		var out *util.SafeBytesBuffer
+
+		BeforeEach(func() {
+			out = &util.SafeBytesBuffer{}
+		})
+
+		It("should select the first match of the query", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			selected, err := finder.Run(strings.NewReader("dev\r"), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(Equal("dev-shoot"))
+			Expect(out.String()).To(ContainSubstring("shoot> dev"))
+			Expect(out.String()).To(ContainSubstring("2/4"))
+		})
+
+		It("should move the cursor with the arrow keys", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			selected, err := finder.Run(strings.NewReader("\x1b[B\x1b[B\x1b[A\r"), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(Equal("dev-shoot"))
+		})
+
+		It("should wrap around when moving the cursor up", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			selected, err := finder.Run(strings.NewReader("\x10\r"), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(Equal("shoot-dev"))
+		})
+
+		It("should handle backspace", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			selected, err := finder.Run(strings.NewReader("devx\x7f\x0e\r"), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(Equal("shoot-dev"))
+		})
+
+		It("should ignore enter if nothing matches", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			selected, err := finder.Run(strings.NewReader("xyz\r\x15prod\r"), out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selected).To(Equal("prod-shoot"))
+		})
+
+		It("should abort on ctrl-c", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			_, err := finder.Run(strings.NewReader("dev\x03"), out)
+			Expect(err).To(MatchError(util.ErrSelectionAborted))
+		})
+
+		It("should abort on end of input", func() {
+			finder := util.NewFuzzyFinder("shoot> ", items)
+
+			_, err := finder.Run(strings.NewReader("dev"), out)
+			Expect(err).To(MatchError(util.ErrSelectionAborted))
+		})
+	})
+
+	Describe("#Pick", func() {
+		It("should fail if the input is not a terminal", func() {
+			streams, _, _, _ := util.NewTestIOStreams()
+
+			_, err := util.Pick(streams, "shoot> ", items)
+			Expect(err).To(MatchError(util.ErrNoTerminal))
+		})
+	})
+})
@@ -0,0 +1,273 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// SessionsDirectoryName is the name of the directory below the garden temp directory that contains the session directories.
+	SessionsDirectoryName = "sessions"
+	// kubeconfigSymlinkName is the name of the symlink to the kubeconfig of the current target.
+	kubeconfigSymlinkName = "kubeconfig.yaml"
+	// providerConfigDirectoryName is the name of the directory that contains the provider CLI configuration directories.
+	providerConfigDirectoryName = ".config"
+	// lastCleanFileName is the name of the file whose modification time records the last automatic cleanup.
+	lastCleanFileName = ".last-clean"
+	// autoCleanInterval is the minimum time between two automatic cleanups.
+	autoCleanInterval = time.Hour
+)
+
+// kubeconfigFilePattern matches the kubeconfig files written to the session directory.
+var kubeconfigFilePattern = regexp.MustCompile(`^kubeconfig\.[0-9a-f]{32}\.yaml$`)
+
+// Session describes a gardenctl session directory.
+type Session struct {
+	// ID is the session ID
+	ID string `json:"id"`
+	// Directory is the path of the session directory
+	Directory string `json:"directory"`
+	// Current is true for the session of the current shell
+	Current bool `json:"current"`
+	// LastActivity is the latest modification time of the session directory or any of its files
+	LastActivity time.Time `json:"lastActivity"`
+	// Kubeconfigs is the number of kubeconfig files in the session directory
+	Kubeconfigs int `json:"kubeconfigs"`
+}
+
+// ListSessions returns all sessions in the given sessions directory, sorted by session ID.
+func ListSessions(sessionsDir, currentSessionID string) ([]Session, error) {
+	entries, err := os.ReadDir(sessionsDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []Session{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to read sessions directory: %w", err)
+	}
+
+	sessions := []Session{}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		dir := filepath.Join(sessionsDir, entry.Name())
+
+		lastActivity, err := latestModTime(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		kubeconfigs, err := kubeconfigFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, Session{
+			ID:           entry.Name(),
+			Directory:    dir,
+			Current:      entry.Name() == currentSessionID,
+			LastActivity: lastActivity,
+			Kubeconfigs:  len(kubeconfigs),
+		})
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ID < sessions[j].ID
+	})
+
+	return sessions, nil
+}
+
+// CleanSessions removes the session directories that have been inactive for longer than ttl.
+// The directory of the current session is never removed. Within the remaining session directories,
+// kubeconfig files that are not the target of the kubeconfig.yaml symlink and provider configuration
+// directories are removed if they have not been modified for longer than ttl. Kubeconfig files of
+// sessions without the kubeconfig.yaml symlink are kept, as the one in use cannot be determined.
+// If dryRun is true, nothing is removed. The paths that are (or would be) removed are returned.
+func CleanSessions(sessionsDir, currentSessionID string, ttl time.Duration, now time.Time, dryRun bool) ([]string, error) {
+	sessions, err := ListSessions(sessionsDir, currentSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := func(t time.Time) bool {
+		return now.Sub(t) > ttl
+	}
+
+	var stale []string
+
+	for _, session := range sessions {
+		if !session.Current && expired(session.LastActivity) {
+			stale = append(stale, session.Directory)
+			continue
+		}
+
+		paths, err := staleSessionFiles(session.Directory, expired)
+		if err != nil {
+			return nil, err
+		}
+
+		stale = append(stale, paths...)
+	}
+
+	if dryRun {
+		return stale, nil
+	}
+
+	removed := make([]string, 0, len(stale))
+
+	for _, path := range stale {
+		if err := os.RemoveAll(path); err != nil {
+			return removed, fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+
+		removed = append(removed, path)
+	}
+
+	return removed, nil
+}
+
+// AutoCleanSessions runs CleanSessions at most once per hour. The time of the last cleanup is
+// recorded in the sessions directory.
+func AutoCleanSessions(sessionsDir, currentSessionID string, ttl time.Duration, now time.Time) error {
+	marker := filepath.Join(sessionsDir, lastCleanFileName)
+
+	if info, err := os.Stat(marker); err == nil && now.Sub(info.ModTime()) < autoCleanInterval {
+		return nil
+	}
+
+	if err := os.WriteFile(marker, nil, 0o600); err != nil {
+		return fmt.Errorf("failed to record session cleanup: %w", err)
+	}
+
+	if err := os.Chtimes(marker, now, now); err != nil {
+		return fmt.Errorf("failed to record session cleanup: %w", err)
+	}
+
+	_, err := CleanSessions(sessionsDir, currentSessionID, ttl, now, false)
+
+	return err
+}
+
+// staleSessionFiles returns the expired kubeconfig files and provider configuration directories of a session.
+func staleSessionFiles(sessionDir string, expired func(time.Time) bool) ([]string, error) {
+	var stale []string
+
+	linkTarget, err := os.Readlink(filepath.Join(sessionDir, kubeconfigSymlinkName))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read kubeconfig symlink: %w", err)
+	}
+
+	if linkTarget != "" && !filepath.IsAbs(linkTarget) {
+		linkTarget = filepath.Join(sessionDir, linkTarget)
+	}
+
+	var kubeconfigs []string
+
+	// Without the kubeconfig.yaml symlink, the KUBECONFIG of the shell points directly to one of the
+	// kubeconfig files, which cannot be told apart from the others. Therefore, none of them is removed.
+	if linkTarget != "" {
+		kubeconfigs, err = kubeconfigFiles(sessionDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, kubeconfig := range kubeconfigs {
+		if kubeconfig == filepath.Clean(linkTarget) {
+			continue
+		}
+
+		info, err := os.Lstat(kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+
+		if expired(info.ModTime()) {
+			stale = append(stale, kubeconfig)
+		}
+	}
+
+	providerConfigDir := filepath.Join(sessionDir, providerConfigDirectoryName)
+
+	entries, err := os.ReadDir(providerConfigDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read provider configuration directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		dir := filepath.Join(providerConfigDir, entry.Name())
+
+		modTime, err := latestModTime(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		if expired(modTime) {
+			stale = append(stale, dir)
+		}
+	}
+
+	return stale, nil
+}
+
+// kubeconfigFiles returns the paths of the kubeconfig files written to the session directory.
+func kubeconfigFiles(sessionDir string) ([]string, error) {
+	entries, err := os.ReadDir(sessionDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session directory: %w", err)
+	}
+
+	var files []string
+
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && kubeconfigFilePattern.MatchString(entry.Name()) {
+			files = append(files, filepath.Join(sessionDir, entry.Name()))
+		}
+	}
+
+	return files, nil
+}
+
+// latestModTime returns the latest modification time of path and, if it is a directory, of all files below it.
+func latestModTime(path string) (time.Time, error) {
+	var latest time.Time
+
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to determine last modification of %s: %w", path, err)
+	}
+
+	return latest, nil
+}
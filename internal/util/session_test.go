@@ -0,0 +1,158 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package util_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+var _ = Describe("Session", func() {
+	const (
+		linkedKubeconfig = "kubeconfig.00000000000000000000000000000001.yaml"
+		staleKubeconfig  = "kubeconfig.00000000000000000000000000000002.yaml"
+		recentKubeconfig = "kubeconfig.00000000000000000000000000000003.yaml"
+		ttl              = 24 * time.Hour
+	)
+
+	var (
+		sessionsDir string
+		now         time.Time
+		old         time.Time
+	)
+
+	// touch creates the given file and sets its modification time.
+	touch := func(path string, modTime time.Time) {
+		ExpectWithOffset(1, os.MkdirAll(filepath.Dir(path), 0o700)).To(Succeed())
+		ExpectWithOffset(1, os.WriteFile(path, nil, 0o600)).To(Succeed())
+		ExpectWithOffset(1, os.Chtimes(path, modTime, modTime)).To(Succeed())
+	}
+
+	// touchDir sets the modification time of the given existing directory.
+	touchDir := func(path string, modTime time.Time) {
+		ExpectWithOffset(1, os.Chtimes(path, modTime, modTime)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		sessionsDir = filepath.Join(GinkgoT().TempDir(), util.SessionsDirectoryName)
+		now = time.Now().Truncate(time.Second)
+		old = now.Add(-2 * ttl)
+
+		// an inactive session
+		touch(filepath.Join(sessionsDir, "inactive", "target.yaml"), old)
+		touch(filepath.Join(sessionsDir, "inactive", staleKubeconfig), old)
+		touchDir(filepath.Join(sessionsDir, "inactive"), old)
+
+		// an active session with stale files
+		active := filepath.Join(sessionsDir, "active")
+		touch(filepath.Join(active, linkedKubeconfig), old)
+		touch(filepath.Join(active, staleKubeconfig), old)
+		touch(filepath.Join(active, recentKubeconfig), now)
+		touch(filepath.Join(active, ".config", "aws", "credentials"), old)
+		touchDir(filepath.Join(active, ".config", "aws"), old)
+		touch(filepath.Join(active, ".config", "gcloud", "credentials.json"), now)
+		Expect(os.Symlink(filepath.Join(active, linkedKubeconfig), filepath.Join(active, "kubeconfig.yaml"))).To(Succeed())
+		touch(filepath.Join(active, "target.yaml"), now)
+
+		// the current session, which has not been used for a long time
+		touch(filepath.Join(sessionsDir, "current", "target.yaml"), old)
+		touchDir(filepath.Join(sessionsDir, "current"), old)
+	})
+
+	Describe("#ListSessions", func() {
+		It("should list all sessions", func() {
+			sessions, err := util.ListSessions(sessionsDir, "current")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(HaveLen(3))
+
+			Expect(sessions[0].ID).To(Equal("active"))
+			Expect(sessions[0].Current).To(BeFalse())
+			Expect(sessions[0].Kubeconfigs).To(Equal(3))
+			Expect(sessions[0].LastActivity.After(old)).To(BeTrue())
+
+			Expect(sessions[1].ID).To(Equal("current"))
+			Expect(sessions[1].Current).To(BeTrue())
+			Expect(sessions[1].LastActivity).To(BeTemporally("==", old))
+
+			Expect(sessions[2].ID).To(Equal("inactive"))
+			Expect(sessions[2].Kubeconfigs).To(Equal(1))
+		})
+
+		It("should return an empty list if the sessions directory does not exist", func() {
+			sessions, err := util.ListSessions(filepath.Join(sessionsDir, "missing"), "current")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(BeEmpty())
+		})
+	})
+
+	Describe("#CleanSessions", func() {
+		expected := func() []string {
+			return []string{
+				filepath.Join(sessionsDir, "active", staleKubeconfig),
+				filepath.Join(sessionsDir, "active", ".config", "aws"),
+				filepath.Join(sessionsDir, "inactive"),
+			}
+		}
+
+		It("should remove inactive sessions and stale files", func() {
+			removed, err := util.CleanSessions(sessionsDir, "current", ttl, now, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(ConsistOf(expected()))
+
+			for _, path := range expected() {
+				Expect(path).NotTo(BeAnExistingFile())
+			}
+
+			Expect(filepath.Join(sessionsDir, "current", "target.yaml")).To(BeAnExistingFile())
+			Expect(filepath.Join(sessionsDir, "active", linkedKubeconfig)).To(BeAnExistingFile())
+			Expect(filepath.Join(sessionsDir, "active", recentKubeconfig)).To(BeAnExistingFile())
+			Expect(filepath.Join(sessionsDir, "active", ".config", "gcloud")).To(BeADirectory())
+		})
+
+		It("should keep the kubeconfig files of a session without kubeconfig symlink", func() {
+			touch(filepath.Join(sessionsDir, "current", staleKubeconfig), old)
+			touchDir(filepath.Join(sessionsDir, "current"), old)
+
+			removed, err := util.CleanSessions(sessionsDir, "current", ttl, now, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(ConsistOf(expected()))
+			Expect(filepath.Join(sessionsDir, "current", staleKubeconfig)).To(BeAnExistingFile())
+		})
+
+		It("should not remove anything in dry-run mode", func() {
+			removed, err := util.CleanSessions(sessionsDir, "current", ttl, now, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(ConsistOf(expected()))
+
+			for _, path := range expected() {
+				Expect(path).To(BeAnExistingFile())
+			}
+		})
+	})
+
+	Describe("#AutoCleanSessions", func() {
+		It("should clean up at most once per hour", func() {
+			Expect(util.AutoCleanSessions(sessionsDir, "current", ttl, now)).To(Succeed())
+			Expect(filepath.Join(sessionsDir, "inactive")).NotTo(BeAnExistingFile())
+
+			touch(filepath.Join(sessionsDir, "inactive", "target.yaml"), old)
+			touchDir(filepath.Join(sessionsDir, "inactive"), old)
+
+			Expect(util.AutoCleanSessions(sessionsDir, "current", ttl, now.Add(30*time.Minute))).To(Succeed())
+			Expect(filepath.Join(sessionsDir, "inactive")).To(BeADirectory())
+
+			Expect(util.AutoCleanSessions(sessionsDir, "current", ttl, now.Add(2*time.Hour))).To(Succeed())
+			Expect(filepath.Join(sessionsDir, "inactive")).NotTo(BeAnExistingFile())
+		})
+	})
+})
@@ -12,6 +12,9 @@ import (
 	"unicode"
 )
 
+// None is printed instead of values that are not set.
+const None = "<none>"
+
 func FilterStringsByPrefix(prefix string, values []string) []string {
 	if prefix == "" {
 		return values
@@ -55,3 +58,12 @@ func StripUnsafe(s string) string {
 		return -1
 	}, s)
 }
+
+// ValueOrNone returns the value or None if it is empty.
+func ValueOrNone(value string) string {
+	if value == "" {
+		return None
+	}
+
+	return value
+}
@@ -32,4 +32,11 @@ var _ = Describe("String Utilities", func() {
 			Expect(util.ShellEscape("a", "b")).To(Equal("'a' 'b'"))
 		})
 	})
+
+	Describe("printing optional values", func() {
+		It("should return the value or none", func() {
+			Expect(util.ValueOrNone("aws")).To(Equal("aws"))
+			Expect(util.ValueOrNone("")).To(Equal(util.None))
+		})
+	})
 })
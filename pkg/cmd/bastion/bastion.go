@@ -0,0 +1,158 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bastion
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/sshpatch"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// NewCmdBastion returns a new bastion command.
+func NewCmdBastion(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bastion",
+		Short: "List, describe, delete or extend the bastions of the current target",
+		Long: `List, describe, delete or extend the bastions of the current target.
+Bastions are created by the ssh command and are usually removed when the ssh command exits.
+Bastions created with --keep-bastion are kept until they expire, and can be managed with these commands.
+By default, only the bastions created by the current user are considered.`,
+	}
+
+	cmd.AddCommand(newCmdList(f, ioStreams))
+	cmd.AddCommand(newCmdDescribe(f, ioStreams))
+	cmd.AddCommand(newCmdDelete(f, ioStreams))
+	cmd.AddCommand(newCmdExtend(f, ioStreams))
+
+	return cmd
+}
+
+// newCmdList returns a new bastion list command.
+func newCmdList(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newListOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the bastions of the current target",
+		Long:    "List the bastions of the current target, including their ingress address, expiration and allowed CIDRs",
+		Example: `# list your bastions of the targeted shoot
+gardenctl bastion list
+
+# list the bastions of all users in project my-project
+gardenctl bastion list --project my-project --all-users`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.Options.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+	addTargetFlags(f, ioStreams, cmd)
+
+	return cmd
+}
+
+// newCmdDescribe returns a new bastion describe command.
+func newCmdDescribe(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newDescribeOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:   "describe BASTION_NAME",
+		Short: "Show the details of a bastion",
+		Example: `# show the details of one of your bastions
+gardenctl bastion describe cli-xxxxxxxx
+
+# print the bastion resource in YAML format
+gardenctl bastion describe cli-xxxxxxxx -o yaml`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              base.WrapRunE(o, f),
+		ValidArgsFunction: bastionNameCompletionFunc(f, ioStreams, 1),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.Options.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+	addTargetFlags(f, ioStreams, cmd)
+
+	return cmd
+}
+
+// newCmdDelete returns a new bastion delete command.
+func newCmdDelete(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newDeleteOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:   "delete [BASTION_NAME...]",
+		Short: "Delete bastions of the current target",
+		Example: `# delete one of your bastions
+gardenctl bastion delete cli-xxxxxxxx
+
+# delete all your bastions of the targeted shoot
+gardenctl bastion delete --all
+
+# delete the bastions of all users of the targeted project without asking for confirmation
+gardenctl bastion delete --all --all-users --yes`,
+		RunE:              base.WrapRunE(o, f),
+		ValidArgsFunction: bastionNameCompletionFunc(f, ioStreams, 0),
+	}
+
+	o.AddFlags(cmd.Flags())
+	addTargetFlags(f, ioStreams, cmd)
+
+	return cmd
+}
+
+// newCmdExtend returns a new bastion extend command.
+func newCmdExtend(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newExtendOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:   "extend BASTION_NAME...",
+		Short: "Extend the lifetime of bastions",
+		Long: `Extend the lifetime of bastions by annotating them once with "gardener.cloud/operation=keepalive".
+Gardener then resets the expiration timestamp of the bastions.`,
+		Example: `# extend the lifetime of one of your bastions
+gardenctl bastion extend cli-xxxxxxxx`,
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              base.WrapRunE(o, f),
+		ValidArgsFunction: bastionNameCompletionFunc(f, ioStreams, 0),
+	}
+
+	o.AddFlags(cmd.Flags())
+	addTargetFlags(f, ioStreams, cmd)
+
+	return cmd
+}
+
+// addTargetFlags adds the target flags that scope the bastions to the command.
+func addTargetFlags(f util.Factory, ioStreams util.IOStreams, cmd *cobra.Command) {
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddSeedFlag(cmd.Flags())
+	f.TargetFlags().AddShootFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+}
+
+// bastionNameCompletionFunc completes the names of the bastions of the current user.
+// If maxArgs is greater than zero, no more names are completed once maxArgs names are given.
+func bastionNameCompletionFunc(f util.Factory, ioStreams util.IOStreams, maxArgs int) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if maxArgs > 0 && len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		bastionNames, err := sshpatch.GetBastionNameCompletions(f, cmd, toComplete)
+		if err != nil {
+			fmt.Fprintln(ioStreams.ErrOut, err.Error())
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return bastionNames, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,309 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bastion_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/bastion"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("Bastion Command", func() {
+	const (
+		gardenName = "mygarden"
+		userName   = "jane"
+	)
+
+	var (
+		ctrl          *gomock.Controller
+		factory       *utilmocks.MockFactory
+		manager       *targetmocks.MockManager
+		clock         *utilmocks.MockClock
+		gardenClient  client.Client
+		streams       util.IOStreams
+		in            *util.SafeBytesBuffer
+		out           *util.SafeBytesBuffer
+		errOut        *util.SafeBytesBuffer
+		currentTarget target.Target
+		now           time.Time
+		cmd           *cobra.Command
+	)
+
+	newBastion := func(name, namespace, shootName, createdBy string) *operationsv1alpha1.Bastion {
+		return &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-30 * time.Minute)),
+				Annotations:       map[string]string{"gardener.cloud/created-by": createdBy},
+			},
+			Spec: operationsv1alpha1.BastionSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: shootName},
+				SeedName:     ptr.To("myseed"),
+				ProviderType: ptr.To("aws"),
+				Ingress: []operationsv1alpha1.BastionIngressPolicy{
+					{IPBlock: networkingv1.IPBlock{CIDR: "1.1.1.1/32"}},
+					{IPBlock: networkingv1.IPBlock{CIDR: "dead:beef::/64"}},
+				},
+			},
+			Status: operationsv1alpha1.BastionStatus{
+				Ingress:             &corev1.LoadBalancerIngress{IP: "1.2.3.4"},
+				ExpirationTimestamp: ptr.To(metav1.NewTime(now.Add(time.Hour))),
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: operationsv1alpha1.BastionReady, Status: gardencorev1beta1.ConditionTrue, Message: "Bastion is ready"},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+		clock = utilmocks.NewMockClock(ctrl)
+
+		now = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+		project := &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-prod")},
+		}
+
+		expired := newBastion("cli-old", "garden-prod", "myshoot", userName)
+		expired.Status.ExpirationTimestamp = ptr.To(metav1.NewTime(now.Add(-time.Minute)))
+		expired.Status.Ingress = nil
+		expired.Spec.Ingress = nil
+
+		gardenClient = fake.NewClientWithObjects(
+			project,
+			newBastion("cli-abc", "garden-prod", "myshoot", userName),
+			newBastion("cli-def", "garden-prod", "othershoot", userName),
+			newBastion("cli-ghi", "garden-prod", "myshoot", "john"),
+			newBastion("cli-jkl", "garden-dev", "devshoot", userName),
+			expired,
+		)
+
+		caCert, err := fake.NewCaCert()
+		Expect(err).NotTo(HaveOccurred())
+
+		clientCert, err := fake.NewClientCert(caCert, userName, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		config := fake.NewCertConfig("client-cert", clientCert.CertificatePEM)
+
+		currentTarget = target.NewTarget(gardenName, "prod", "", "myshoot")
+
+		factory.EXPECT().Context().Return(context.Background()).AnyTimes()
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+		factory.EXPECT().Clock().Return(clock).AnyTimes()
+		factory.EXPECT().TargetFlags().Return(target.NewTargetFlags("", "", "", "", false)).AnyTimes()
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		manager.EXPECT().CurrentTarget().DoAndReturn(func() (target.Target, error) {
+			return currentTarget, nil
+		}).AnyTimes()
+		manager.EXPECT().GardenClient(gardenName).Return(clientgarden.NewClient(
+			clientcmd.NewDefaultClientConfig(*config, nil),
+			gardenClient,
+			gardenName,
+		), nil).AnyTimes()
+
+		streams, in, out, errOut = util.NewTestIOStreams()
+		cmd = bastion.NewCmdBastion(factory, streams)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	run := func(args ...string) error {
+		cmd.SetArgs(args)
+		cmd.SetOut(out)
+		cmd.SetErr(errOut)
+
+		return cmd.Execute()
+	}
+
+	Describe("list", func() {
+		It("should list the bastions of the current user for the targeted shoot", func() {
+			Expect(run("list")).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`NAME\s+NAMESPACE\s+SHOOT\s+CREATED BY\s+READY\s+ADDRESS\s+CIDRS\s+EXPIRES\s+AGE\n` +
+				`cli-abc\s+garden-prod\s+myshoot\s+jane\s+True\s+1.2.3.4\s+1.1.1.1/32,dead:beef::/64\s+2025-01-10T13:00:00Z\s+30m\n` +
+				`cli-old\s+garden-prod\s+myshoot\s+jane\s+True\s+<none>\s+<none>\s+2025-01-10T11:59:00Z\s+30m\n$`))
+		})
+
+		It("should list the bastions of all users for the targeted project", func() {
+			currentTarget = target.NewTarget(gardenName, "prod", "", "")
+
+			Expect(run("list", "--all-users", "-o", "yaml")).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("name: cli-abc"))
+			Expect(out.String()).To(ContainSubstring("name: cli-def"))
+			Expect(out.String()).To(ContainSubstring("name: cli-ghi"))
+			Expect(out.String()).NotTo(ContainSubstring("name: cli-jkl"))
+		})
+
+		It("should report if no bastions are found", func() {
+			currentTarget = target.NewTarget(gardenName, "prod", "", "nobastions")
+
+			Expect(run("list")).To(Succeed())
+			Expect(out.String()).To(BeEmpty())
+			Expect(errOut.String()).To(Equal("No bastions found\n"))
+		})
+	})
+
+	Describe("describe", func() {
+		It("should show the details of a bastion", func() {
+			Expect(run("describe", "cli-abc")).To(Succeed())
+			Expect(out.String()).To(Equal(`Name:        cli-abc
+Namespace:   garden-prod
+Shoot:       myshoot
+Seed:        myseed
+Provider:    aws
+Created By:  jane
+Created:     2025-01-10T11:30:00Z (30m ago)
+Ready:       True (Bastion is ready)
+Ingress:     1.2.3.4
+Expires:     2025-01-10T13:00:00Z (in 60m)
+CIDRs:       1.1.1.1/32,dead:beef::/64
+`))
+		})
+
+		It("should not find the bastion of another user", func() {
+			Expect(run("describe", "cli-ghi")).To(MatchError(`bastion "cli-ghi" of the current user not found, use --all-users to include the bastions of other users`))
+		})
+
+		It("should print the bastion of another user as json", func() {
+			Expect(run("describe", "cli-ghi", "--all-users", "-o", "json")).To(Succeed())
+			Expect(out.String()).To(ContainSubstring(`"name": "cli-ghi"`))
+		})
+	})
+
+	Describe("delete", func() {
+		It("should delete the given bastions", func() {
+			Expect(run("delete", "cli-abc", "cli-old")).To(Succeed())
+			Expect(out.String()).To(Equal("Deleted bastion \"cli-abc\"\nDeleted bastion \"cli-old\"\n"))
+
+			err := gardenClient.Get(context.Background(), client.ObjectKey{Namespace: "garden-prod", Name: "cli-abc"}, &operationsv1alpha1.Bastion{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should delete all bastions of the current user for the targeted project", func() {
+			currentTarget = target.NewTarget(gardenName, "prod", "", "")
+
+			Expect(run("delete", "--all")).To(Succeed())
+
+			bastionList := &operationsv1alpha1.BastionList{}
+			Expect(gardenClient.List(context.Background(), bastionList)).To(Succeed())
+			Expect(bastionList.Items).To(ConsistOf(
+				HaveField("Name", "cli-ghi"),
+				HaveField("Name", "cli-jkl"),
+			))
+		})
+
+		Context("including the bastions of other users", func() {
+			BeforeEach(func() {
+				currentTarget = target.NewTarget(gardenName, "prod", "", "")
+			})
+
+			projectBastions := func() []operationsv1alpha1.Bastion {
+				bastionList := &operationsv1alpha1.BastionList{}
+				Expect(gardenClient.List(context.Background(), bastionList, client.InNamespace("garden-prod"))).To(Succeed())
+
+				return bastionList.Items
+			}
+
+			It("should not delete the bastions if the deletion is not confirmed", func() {
+				in.Write([]byte("n\n"))
+
+				Expect(run("delete", "--all", "--all-users")).To(Succeed())
+				Expect(errOut.String()).To(ContainSubstring("cli-ghi (created by john)"))
+				Expect(errOut.String()).NotTo(ContainSubstring("cli-abc"))
+				Expect(errOut.String()).To(HaveSuffix("Do you want to continue? [y/N]: Aborted, no bastions have been deleted\n"))
+				Expect(projectBastions()).To(HaveLen(4))
+			})
+
+			It("should delete the bastions if the deletion is confirmed", func() {
+				in.Write([]byte("y\n"))
+
+				Expect(run("delete", "--all", "--all-users")).To(Succeed())
+				Expect(out.String()).To(ContainSubstring("Deleted bastion \"cli-ghi\"\n"))
+				Expect(projectBastions()).To(BeEmpty())
+			})
+
+			It("should delete the bastions without confirmation", func() {
+				Expect(run("delete", "--all", "--all-users", "--yes")).To(Succeed())
+				Expect(errOut.String()).NotTo(ContainSubstring("Do you want to continue?"))
+				Expect(projectBastions()).To(BeEmpty())
+			})
+
+			It("should not ask for confirmation to delete own bastions", func() {
+				Expect(run("delete", "cli-abc", "--all-users")).To(Succeed())
+				Expect(errOut.String()).To(BeEmpty())
+				Expect(projectBastions()).To(HaveLen(3))
+			})
+		})
+
+		It("should refuse to delete all bastions of the garden", func() {
+			currentTarget = target.NewTarget(gardenName, "", "", "")
+
+			Expect(run("delete", "--all", "--all-users")).To(MatchError("--all requires a targeted project or shoot"))
+
+			bastionList := &operationsv1alpha1.BastionList{}
+			Expect(gardenClient.List(context.Background(), bastionList)).To(Succeed())
+			Expect(bastionList.Items).To(HaveLen(5))
+		})
+
+		It("should refuse to delete all bastions of a seed", func() {
+			currentTarget = target.NewTarget(gardenName, "", "myseed", "")
+
+			Expect(run("delete", "--all")).To(MatchError("--all requires a targeted project or shoot"))
+		})
+
+		It("should require bastion names or --all", func() {
+			Expect(run("delete")).To(MatchError("specify the names of the bastions to delete or use --all"))
+			Expect(run("delete", "cli-abc", "--all")).To(MatchError("bastion names cannot be combined with --all"))
+		})
+	})
+
+	Describe("extend", func() {
+		It("should annotate the bastion with the keepalive operation", func() {
+			Expect(run("extend", "cli-abc")).To(Succeed())
+			Expect(out.String()).To(Equal("Extended the lifetime of bastion \"cli-abc\"\n"))
+
+			bastion := &operationsv1alpha1.Bastion{}
+			Expect(gardenClient.Get(context.Background(), client.ObjectKey{Namespace: "garden-prod", Name: "cli-abc"}, bastion)).To(Succeed())
+			Expect(bastion.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "keepalive"))
+			Expect(bastion.Annotations).To(HaveKeyWithValue("gardener.cloud/created-by", userName))
+		})
+	})
+
+	It("should fail if no garden is targeted", func() {
+		currentTarget = target.NewTarget("", "", "", "")
+
+		Expect(run("list")).To(MatchError(ContainSubstring(target.ErrNoGardenTargeted.Error())))
+	})
+})
@@ -0,0 +1,172 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bastion
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+)
+
+// deleteOptions is a struct to support the bastion delete command.
+type deleteOptions struct {
+	options
+
+	// Names are the names of the bastions to delete
+	Names []string
+
+	// All deletes all bastions of the current target
+	All bool
+
+	// Yes skips the confirmation before deleting the bastions of other users
+	Yes bool
+}
+
+// newDeleteOptions returns initialized options for the bastion delete command.
+func newDeleteOptions(ioStreams util.IOStreams) *deleteOptions {
+	return &deleteOptions{
+		options: options{Options: base.Options{IOStreams: ioStreams}},
+	}
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *deleteOptions) AddFlags(flags *pflag.FlagSet) {
+	o.options.AddFlags(flags)
+	flags.BoolVar(&o.All, "all", o.All, "Delete all bastions of the current target. Requires a targeted project or shoot.")
+	flags.BoolVarP(&o.Yes, "yes", "y", o.Yes, "Delete the bastions of other users without asking for confirmation.")
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *deleteOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	o.Names = args
+
+	if err := o.options.Complete(f, cmd, args); err != nil {
+		return err
+	}
+
+	if o.AllUsers && !o.Yes {
+		// the current user is needed to tell apart the bastions of other users, which must be confirmed
+		ctx, cancel := context.WithTimeout(f.Context(), 30*time.Second)
+		defer cancel()
+
+		user, err := o.GardenClient.CurrentUser(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get current user: %w", err)
+		}
+
+		o.User = user
+	}
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *deleteOptions) Validate() error {
+	if o.All && len(o.Names) > 0 {
+		return errors.New("bastion names cannot be combined with --all")
+	}
+
+	if !o.All && len(o.Names) == 0 {
+		return errors.New("specify the names of the bastions to delete or use --all")
+	}
+
+	// do not delete the bastions of all projects, which would terminate the SSH sessions of other users
+	if o.All && o.CurrentTarget.ProjectName() == "" && o.CurrentTarget.ShootName() == "" {
+		return errors.New("--all requires a targeted project or shoot")
+	}
+
+	return o.options.Validate()
+}
+
+// Run executes the command.
+func (o *deleteOptions) Run(f util.Factory) error {
+	ctx, cancel := context.WithTimeout(f.Context(), 30*time.Second)
+	defer cancel()
+
+	var (
+		bastions []operationsv1alpha1.Bastion
+		err      error
+	)
+
+	if o.All {
+		bastions, err = o.listBastions(ctx)
+	} else {
+		bastions, err = o.findBastions(ctx, o.Names)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(bastions) == 0 {
+		fmt.Fprintln(o.IOStreams.ErrOut, "No bastions found")
+		return nil
+	}
+
+	if !o.Yes && !o.confirmForeignBastions(bastions) {
+		fmt.Fprintln(o.IOStreams.ErrOut, "Aborted, no bastions have been deleted")
+		return nil
+	}
+
+	for i := range bastions {
+		if err := o.GardenClient.DeleteBastion(ctx, &bastions[i]); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(o.IOStreams.Out, "Deleted bastion %q\n", bastions[i].Name)
+	}
+
+	return nil
+}
+
+// confirmForeignBastions asks for confirmation if any of the given bastions has been created by another user,
+// as deleting it terminates the SSH sessions of that user.
+func (o *deleteOptions) confirmForeignBastions(bastions []operationsv1alpha1.Bastion) bool {
+	var foreign []string
+
+	for _, bastion := range bastions {
+		if createdBy := bastion.Annotations[v1beta1constants.GardenCreatedBy]; createdBy != o.User {
+			foreign = append(foreign, fmt.Sprintf("%s (created by %s)", bastion.Name, util.ValueOrNone(createdBy)))
+		}
+	}
+
+	if len(foreign) == 0 {
+		return true
+	}
+
+	fmt.Fprintf(o.IOStreams.ErrOut, "The following bastions have been created by other users, deleting them terminates their SSH sessions:\n")
+
+	for _, bastion := range foreign {
+		fmt.Fprintf(o.IOStreams.ErrOut, "  %s\n", bastion)
+	}
+
+	reader := bufio.NewReader(o.IOStreams.In)
+
+	for {
+		fmt.Fprint(o.IOStreams.ErrOut, "Do you want to continue? [y/N]: ")
+
+		str, _ := reader.ReadString('\n')
+
+		switch strings.ToLower(strings.TrimSpace(str)) {
+		case "y", "yes":
+			return true
+		case "", "n", "no":
+			return false
+		}
+	}
+}
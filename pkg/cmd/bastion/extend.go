@@ -0,0 +1,72 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+)
+
+// extendOptions is a struct to support the bastion extend command.
+type extendOptions struct {
+	options
+
+	// Names are the names of the bastions to extend
+	Names []string
+}
+
+// newExtendOptions returns initialized options for the bastion extend command.
+func newExtendOptions(ioStreams util.IOStreams) *extendOptions {
+	return &extendOptions{
+		options: options{Options: base.Options{IOStreams: ioStreams}},
+	}
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *extendOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	o.Names = args
+
+	return o.options.Complete(f, cmd, args)
+}
+
+// Run executes the command.
+func (o *extendOptions) Run(f util.Factory) error {
+	ctx, cancel := context.WithTimeout(f.Context(), 30*time.Second)
+	defer cancel()
+
+	bastions, err := o.findBastions(ctx, o.Names)
+	if err != nil {
+		return err
+	}
+
+	for i := range bastions {
+		bastion := &bastions[i]
+		oldBastion := bastion.DeepCopy()
+
+		if bastion.Annotations == nil {
+			bastion.Annotations = map[string]string{}
+		}
+
+		// the keepalive annotation resets the expiration timestamp of the bastion and is removed by gardener afterwards
+		bastion.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationKeepalive
+
+		if err := o.GardenClient.PatchBastion(ctx, bastion, oldBastion); err != nil {
+			return fmt.Errorf("failed to extend the lifetime of bastion %q: %w", bastion.Name, err)
+		}
+
+		fmt.Fprintf(o.IOStreams.Out, "Extended the lifetime of bastion %q\n", bastion.Name)
+	}
+
+	return nil
+}
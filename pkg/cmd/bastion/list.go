@@ -0,0 +1,127 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/get"
+)
+
+// listOptions is a struct to support the bastion list command.
+type listOptions struct {
+	options
+}
+
+// newListOptions returns initialized options for the bastion list command.
+func newListOptions(ioStreams util.IOStreams) *listOptions {
+	return &listOptions{
+		options: options{Options: base.Options{IOStreams: ioStreams}},
+	}
+}
+
+// Run executes the command.
+func (o *listOptions) Run(f util.Factory) error {
+	ctx, cancel := context.WithTimeout(f.Context(), 30*time.Second)
+	defer cancel()
+
+	bastions, err := o.listBastions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if o.Output != "" {
+		return o.PrintObject(&operationsv1alpha1.BastionList{Items: bastions})
+	}
+
+	if len(bastions) == 0 {
+		fmt.Fprintln(o.IOStreams.ErrOut, "No bastions found")
+		return nil
+	}
+
+	return o.PrintObject(get.NewBastionTable(bastions, f.Clock().Now()))
+}
+
+// describeOptions is a struct to support the bastion describe command.
+type describeOptions struct {
+	options
+
+	// Name is the name of the bastion to describe
+	Name string
+}
+
+// newDescribeOptions returns initialized options for the bastion describe command.
+func newDescribeOptions(ioStreams util.IOStreams) *describeOptions {
+	return &describeOptions{
+		options: options{Options: base.Options{IOStreams: ioStreams}},
+	}
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *describeOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return o.options.Complete(f, cmd, args)
+}
+
+// Run executes the command.
+func (o *describeOptions) Run(f util.Factory) error {
+	ctx, cancel := context.WithTimeout(f.Context(), 30*time.Second)
+	defer cancel()
+
+	bastions, err := o.findBastions(ctx, []string{o.Name})
+	if err != nil {
+		return err
+	}
+
+	bastion := bastions[0]
+
+	if o.Output != "" {
+		return o.PrintObject(&bastion)
+	}
+
+	now := f.Clock().Now()
+
+	ready := get.BastionReadyStatus(bastion)
+	if condition := corev1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady); condition != nil && condition.Message != "" {
+		ready += " (" + condition.Message + ")"
+	}
+
+	expires := util.None
+	if bastion.Status.ExpirationTimestamp != nil {
+		expires = fmt.Sprintf("%s (%s)", bastion.Status.ExpirationTimestamp.UTC().Format(time.RFC3339), expiresIn(bastion, now))
+	}
+
+	w := tabwriter.NewWriter(o.IOStreams.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", bastion.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", bastion.Namespace)
+	fmt.Fprintf(w, "Shoot:\t%s\n", bastion.Spec.ShootRef.Name)
+	fmt.Fprintf(w, "Seed:\t%s\n", util.ValueOrNone(ptr.Deref(bastion.Spec.SeedName, "")))
+	fmt.Fprintf(w, "Provider:\t%s\n", util.ValueOrNone(ptr.Deref(bastion.Spec.ProviderType, "")))
+	fmt.Fprintf(w, "Created By:\t%s\n", util.ValueOrNone(bastion.Annotations[v1beta1constants.GardenCreatedBy]))
+	fmt.Fprintf(w, "Created:\t%s (%s ago)\n", bastion.CreationTimestamp.UTC().Format(time.RFC3339), duration.HumanDuration(now.Sub(bastion.CreationTimestamp.Time)))
+	fmt.Fprintf(w, "Ready:\t%s\n", ready)
+	fmt.Fprintf(w, "Ingress:\t%s\n", get.BastionAddress(bastion))
+	fmt.Fprintf(w, "Expires:\t%s\n", expires)
+	fmt.Fprintf(w, "CIDRs:\t%s\n", get.BastionCIDRs(bastion))
+
+	return w.Flush()
+}
@@ -0,0 +1,175 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// options is a struct with the options shared by all bastion commands.
+type options struct {
+	base.Options
+
+	// AllUsers includes the bastions of all users instead of only the bastions created by the current user
+	AllUsers bool
+
+	// CurrentTarget holds the current target configuration
+	CurrentTarget target.Target
+
+	// GardenClient is the client for the garden cluster
+	GardenClient clientgarden.Client
+
+	// User is the name of the current user, it is only set if AllUsers is false or if it is required to confirm the deletion of bastions of other users
+	User string
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.AllUsers, "all-users", o.AllUsers, "Include the bastions of all users instead of only the bastions created by the current user.")
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *options) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		return err
+	}
+
+	if currentTarget.GardenName() == "" {
+		return target.ErrNoGardenTargeted
+	}
+
+	o.CurrentTarget = currentTarget
+
+	o.GardenClient, err = manager.GardenClient(currentTarget.GardenName())
+	if err != nil {
+		return fmt.Errorf("failed to create garden cluster client: %w", err)
+	}
+
+	if !o.AllUsers {
+		ctx, cancel := context.WithTimeout(f.Context(), 30*time.Second)
+		defer cancel()
+
+		o.User, err = o.GardenClient.CurrentUser(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get current user: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listBastions returns the bastions of the current target, sorted by namespace and name.
+// Unless AllUsers is set, only the bastions created by the current user are returned.
+func (o *options) listBastions(ctx context.Context) ([]operationsv1alpha1.Bastion, error) {
+	listOption := clientgarden.ProjectFilter{}
+
+	if o.CurrentTarget.ShootName() != "" {
+		listOption["spec.shootRef.name"] = o.CurrentTarget.ShootName()
+	}
+
+	if o.CurrentTarget.ProjectName() != "" {
+		listOption["project"] = o.CurrentTarget.ProjectName()
+	} else if o.CurrentTarget.SeedName() != "" {
+		listOption[gardencore.ShootSeedName] = o.CurrentTarget.SeedName()
+	}
+
+	list, err := o.GardenClient.ListBastions(ctx, listOption)
+	if err != nil {
+		return nil, err
+	}
+
+	bastions := []operationsv1alpha1.Bastion{}
+
+	for _, bastion := range list.Items {
+		if o.AllUsers || bastion.Annotations[v1beta1constants.GardenCreatedBy] == o.User {
+			bastions = append(bastions, bastion)
+		}
+	}
+
+	sort.Slice(bastions, func(i, j int) bool {
+		if bastions[i].Namespace != bastions[j].Namespace {
+			return bastions[i].Namespace < bastions[j].Namespace
+		}
+
+		return bastions[i].Name < bastions[j].Name
+	})
+
+	return bastions, nil
+}
+
+// findBastions returns the bastions with the given names in the order of the names.
+func (o *options) findBastions(ctx context.Context, names []string) ([]operationsv1alpha1.Bastion, error) {
+	bastions, err := o.listBastions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []operationsv1alpha1.Bastion
+
+	for _, name := range names {
+		index := -1
+
+		for i := range bastions {
+			if bastions[i].Name != name {
+				continue
+			}
+
+			if index >= 0 {
+				return nil, fmt.Errorf("bastion name %q is ambiguous, target the project of the bastion", name)
+			}
+
+			index = i
+		}
+
+		if index < 0 {
+			if o.AllUsers {
+				return nil, fmt.Errorf("bastion %q not found", name)
+			}
+
+			return nil, fmt.Errorf("bastion %q of the current user not found, use --all-users to include the bastions of other users", name)
+		}
+
+		found = append(found, bastions[index])
+	}
+
+	return found, nil
+}
+
+// expiresIn returns the human-readable duration until the bastion expires.
+func expiresIn(bastion operationsv1alpha1.Bastion, now time.Time) string {
+	if bastion.Status.ExpirationTimestamp == nil {
+		return util.None
+	}
+
+	remaining := bastion.Status.ExpirationTimestamp.Sub(now)
+	if remaining <= 0 {
+		return "expired"
+	}
+
+	return "in " + duration.HumanDuration(remaining)
+}
@@ -22,14 +22,20 @@ import (
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
 	"github.com/gardener/gardenctl-v2/internal/util"
+	cmdbastion "github.com/gardener/gardenctl-v2/pkg/cmd/bastion"
 	cmdconfig "github.com/gardener/gardenctl-v2/pkg/cmd/config"
+	cmdget "github.com/gardener/gardenctl-v2/pkg/cmd/get"
 	"github.com/gardener/gardenctl-v2/pkg/cmd/kubeconfig"
 	cmdkubectl "github.com/gardener/gardenctl-v2/pkg/cmd/kubectlenv"
 	cmdprovider "github.com/gardener/gardenctl-v2/pkg/cmd/providerenv"
 	cmdrc "github.com/gardener/gardenctl-v2/pkg/cmd/rc"
 	"github.com/gardener/gardenctl-v2/pkg/cmd/resolve"
+	cmdsearch "github.com/gardener/gardenctl-v2/pkg/cmd/search"
+	cmdsession "github.com/gardener/gardenctl-v2/pkg/cmd/session"
+	cmdshoot "github.com/gardener/gardenctl-v2/pkg/cmd/shoot"
 	cmdssh "github.com/gardener/gardenctl-v2/pkg/cmd/ssh"
 	cmdsshpatch "github.com/gardener/gardenctl-v2/pkg/cmd/sshpatch"
+	cmdstatus "github.com/gardener/gardenctl-v2/pkg/cmd/status"
 	cmdtarget "github.com/gardener/gardenctl-v2/pkg/cmd/target"
 	cmdversion "github.com/gardener/gardenctl-v2/pkg/cmd/version"
 )
@@ -121,7 +127,9 @@ Find more information at: https://github.com/gardener/gardenctl-v2/blob/master/R
 
 	// add subcommands
 	cmd.AddCommand(cmdssh.NewCmdSSH(f, cmdssh.NewSSHOptions(ioStreams)))
+	cmd.AddCommand(cmdssh.NewCmdSCP(f, cmdssh.NewSCPOptions(ioStreams)))
 	cmd.AddCommand(cmdsshpatch.NewCmdSSHPatch(f, ioStreams))
+	cmd.AddCommand(cmdbastion.NewCmdBastion(f, ioStreams))
 	cmd.AddCommand(cmdtarget.NewCmdTarget(f, ioStreams))
 	cmd.AddCommand(cmdversion.NewCmdVersion(f, cmdversion.NewVersionOptions(ioStreams)))
 	cmd.AddCommand(cmdconfig.NewCmdConfig(f, ioStreams))
@@ -130,6 +138,11 @@ Find more information at: https://github.com/gardener/gardenctl-v2/blob/master/R
 	cmd.AddCommand(cmdrc.NewCmdRC(f, ioStreams))
 	cmd.AddCommand(kubeconfig.NewCmdKubeconfig(f, ioStreams))
 	cmd.AddCommand(resolve.NewCmdResolve(f, ioStreams))
+	cmd.AddCommand(cmdsearch.NewCmdSearch(f, ioStreams))
+	cmd.AddCommand(cmdget.NewCmdGet(f, ioStreams))
+	cmd.AddCommand(cmdstatus.NewCmdStatus(f, ioStreams))
+	cmd.AddCommand(cmdshoot.NewCmdShoot(f, ioStreams))
+	cmd.AddCommand(cmdsession.NewCmdSession(f, ioStreams))
 
 	return cmd
 }
@@ -0,0 +1,135 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package get
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// NewCmdGet returns a new get command.
+func NewCmdGet(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Display Gardener resources of the current target",
+		Long: `Display Gardener resources of the current target.
+The resources are listed from the targeted garden and are scoped by the current target or the target flags.`,
+	}
+
+	cmd.AddCommand(newCmdGetShoots(f, ioStreams))
+	cmd.AddCommand(newCmdGetProjects(f, ioStreams))
+	cmd.AddCommand(newCmdGetSeeds(f, ioStreams))
+	cmd.AddCommand(newCmdGetBastions(f, ioStreams))
+
+	return cmd
+}
+
+// newCmdGetShoots returns a new get shoots command.
+func newCmdGetShoots(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newOptions(ioStreams, KindShoots)
+	cmd := &cobra.Command{
+		Use:     "shoots",
+		Aliases: []string{"shoot"},
+		Short:   "Display the shoots of the targeted project or seed",
+		Long:    "Display the shoots of the targeted project or seed. If neither a project nor a seed is targeted, all shoots of the targeted garden are displayed",
+		Example: `# list the shoots of the targeted project
+gardenctl get shoots
+
+# list the shoots of project my-project with additional columns
+gardenctl get shoots --project my-project -o wide`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddSeedFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
+
+// newCmdGetProjects returns a new get projects command.
+func newCmdGetProjects(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newOptions(ioStreams, KindProjects)
+	cmd := &cobra.Command{
+		Use:     "projects",
+		Aliases: []string{"project"},
+		Short:   "Display the projects of the targeted garden",
+		Example: `# list the projects of the targeted garden
+gardenctl get projects
+
+# list the projects of garden my-garden in YAML format
+gardenctl get projects --garden my-garden -o yaml`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
+
+// newCmdGetSeeds returns a new get seeds command.
+func newCmdGetSeeds(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newOptions(ioStreams, KindSeeds)
+	cmd := &cobra.Command{
+		Use:     "seeds",
+		Aliases: []string{"seed"},
+		Short:   "Display the seeds of the targeted garden",
+		Example: `# list the seeds of the targeted garden
+gardenctl get seeds`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
+
+// newCmdGetBastions returns a new get bastions command.
+func newCmdGetBastions(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := newOptions(ioStreams, KindBastions)
+	cmd := &cobra.Command{
+		Use:     "bastions",
+		Aliases: []string{"bastion"},
+		Short:   "Display the bastions of the targeted project or shoot",
+		Long:    "Display the bastions of the targeted project or shoot. If no project is targeted, all bastions of the targeted garden are displayed",
+		Example: `# list the bastions of the targeted shoot
+gardenctl get bastions
+
+# list the bastions of project my-project with additional columns
+gardenctl get bastions --project my-project -o wide`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddShootFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
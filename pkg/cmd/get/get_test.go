@@ -0,0 +1,234 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package get_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/get"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("Get Command", func() {
+	const gardenName = "mygarden"
+
+	var (
+		ctrl          *gomock.Controller
+		factory       *utilmocks.MockFactory
+		manager       *targetmocks.MockManager
+		clock         *utilmocks.MockClock
+		streams       util.IOStreams
+		out           *util.SafeBytesBuffer
+		errOut        *util.SafeBytesBuffer
+		currentTarget target.Target
+		now           time.Time
+		cmd           *cobra.Command
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+		clock = utilmocks.NewMockClock(ctrl)
+
+		now = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		created := metav1.NewTime(now.Add(-48 * time.Hour))
+
+		project := &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod", CreationTimestamp: created},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To("garden-prod"),
+				Owner:     &rbacv1.Subject{Kind: rbacv1.UserKind, Name: "owner"},
+				Purpose:   ptr.To("production"),
+			},
+			Status: gardencorev1beta1.ProjectStatus{Phase: gardencorev1beta1.ProjectReady},
+		}
+		otherProject := &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", CreationTimestamp: created},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")},
+		}
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "myshoot",
+				Namespace:         "garden-prod",
+				CreationTimestamp: created,
+				Labels:            map[string]string{"shoot.gardener.cloud/status": "healthy"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName:    ptr.To("myseed"),
+				Region:      "eu-west-1",
+				Provider:    gardencorev1beta1.Provider{Type: "aws"},
+				Kubernetes:  gardencorev1beta1.Kubernetes{Version: "1.31.2"},
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:     gardencorev1beta1.LastOperationTypeReconcile,
+					State:    gardencorev1beta1.LastOperationStateProcessing,
+					Progress: 42,
+				},
+			},
+		}
+		otherShoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "devshoot", Namespace: "garden-dev", CreationTimestamp: created},
+			Spec:       gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.0"}},
+		}
+		seed := &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "myseed", CreationTimestamp: created},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "aws", Region: "eu-west-1"},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				KubernetesVersion: ptr.To("1.30.5"),
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+		bastion := &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "cli-abc",
+				Namespace:         "garden-prod",
+				CreationTimestamp: created,
+				Annotations:       map[string]string{"gardener.cloud/created-by": "jane"},
+			},
+			Spec: operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: "myshoot"}},
+			Status: operationsv1alpha1.BastionStatus{
+				Ingress: &corev1.LoadBalancerIngress{IP: "1.2.3.4"},
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: operationsv1alpha1.BastionReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+		otherBastion := &operationsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{Name: "cli-def", Namespace: "garden-prod", CreationTimestamp: created},
+			Spec:       operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: "othershoot"}},
+		}
+
+		gardenClient := clientgarden.NewClient(nil, fake.NewClientWithObjects(
+			project, otherProject, shoot, otherShoot, seed, bastion, otherBastion,
+		), gardenName)
+
+		currentTarget = target.NewTarget(gardenName, "prod", "", "")
+
+		factory.EXPECT().Context().Return(context.Background()).AnyTimes()
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+		factory.EXPECT().Clock().Return(clock).AnyTimes()
+		factory.EXPECT().TargetFlags().Return(target.NewTargetFlags("", "", "", "", false)).AnyTimes()
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		manager.EXPECT().CurrentTarget().DoAndReturn(func() (target.Target, error) {
+			return currentTarget, nil
+		}).AnyTimes()
+		manager.EXPECT().GardenClient(gardenName).Return(gardenClient, nil).AnyTimes()
+
+		streams, _, out, errOut = util.NewTestIOStreams()
+		cmd = get.NewCmdGet(factory, streams)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	run := func(args ...string) error {
+		cmd.SetArgs(args)
+		cmd.SetOut(out)
+		cmd.SetErr(errOut)
+
+		return cmd.Execute()
+	}
+
+	Describe("shoots", func() {
+		It("should list the shoots of the targeted project", func() {
+			Expect(run("shoots")).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`PROJECT\s+NAME\s+SEED\s+VERSION\s+HIBERNATED\s+LAST OPERATION\s+AGE\n` +
+				`prod\s+myshoot\s+myseed\s+1.31.2\s+true\s+Reconcile Processing \(42%\)\s+2d\n$`))
+		})
+
+		It("should list all shoots of the garden with additional columns", func() {
+			currentTarget = target.NewTarget(gardenName, "", "", "")
+
+			Expect(run("shoots", "-o", "wide")).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`PROVIDER\s+REGION\s+PURPOSE\s+STATUS\n`))
+			Expect(out.String()).To(MatchRegexp(`dev\s+devshoot\s+<none>\s+1.30.0\s+false\s+<none>\s+2d\s+<none>\s+<none>\s+<none>\s+unknown\n`))
+			Expect(out.String()).To(MatchRegexp(`prod\s+myshoot\s+myseed\s+1.31.2\s+true\s+Reconcile Processing \(42%\)\s+2d\s+aws\s+eu-west-1\s+<none>\s+healthy\n`))
+		})
+
+		It("should print the shoot list as json", func() {
+			Expect(run("shoots", "-o", "json")).To(Succeed())
+			Expect(out.String()).To(ContainSubstring(`"name": "myshoot"`))
+			Expect(out.String()).NotTo(ContainSubstring("devshoot"))
+		})
+
+		It("should report if no shoots are found", func() {
+			currentTarget = target.NewTarget(gardenName, "", "otherseed", "")
+
+			Expect(run("shoots")).To(Succeed())
+			Expect(out.String()).To(BeEmpty())
+			Expect(errOut.String()).To(Equal("No shoots found\n"))
+		})
+	})
+
+	Describe("projects", func() {
+		It("should list the projects of the targeted garden", func() {
+			Expect(run("projects", "-o", "wide")).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`NAME\s+NAMESPACE\s+STATUS\s+AGE\s+OWNER\s+PURPOSE\n` +
+				`dev\s+garden-dev\s+<none>\s+2d\s+<none>\s+<none>\n` +
+				`prod\s+garden-prod\s+Ready\s+2d\s+owner\s+production\n$`))
+		})
+	})
+
+	Describe("seeds", func() {
+		It("should list the seeds of the targeted garden", func() {
+			Expect(run("seeds")).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`NAME\s+STATUS\s+PROVIDER\s+REGION\s+VERSION\s+AGE\n` +
+				`myseed\s+Ready\s+aws\s+eu-west-1\s+1.30.5\s+2d\n$`))
+		})
+	})
+
+	Describe("bastions", func() {
+		It("should list the bastions of the targeted shoot", func() {
+			currentTarget = target.NewTarget(gardenName, "prod", "", "myshoot")
+
+			Expect(run("bastions")).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`NAME\s+NAMESPACE\s+SHOOT\s+CREATED BY\s+READY\s+ADDRESS\s+CIDRS\s+EXPIRES\s+AGE\n` +
+				`cli-abc\s+garden-prod\s+myshoot\s+jane\s+True\s+1.2.3.4\s+<none>\s+<none>\s+2d\n$`))
+		})
+
+		It("should list all bastions of the targeted project as yaml", func() {
+			Expect(run("bastions", "-o", "yaml")).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("name: cli-abc"))
+			Expect(out.String()).To(ContainSubstring("name: cli-def"))
+		})
+	})
+
+	It("should fail if no garden is targeted", func() {
+		currentTarget = target.NewTarget("", "", "", "")
+
+		Expect(run("shoots")).To(MatchError(ContainSubstring(target.ErrNoGardenTargeted.Error())))
+	})
+
+	It("should reject an unsupported output format", func() {
+		Expect(run("seeds", "-o", "table")).To(MatchError("--output must be either 'yaml', 'json' or 'wide'"))
+	})
+})
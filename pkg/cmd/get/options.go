@@ -0,0 +1,234 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// Kind is representing the type of resources that can be listed.
+type Kind string
+
+const (
+	KindShoots   Kind = "shoots"
+	KindProjects Kind = "projects"
+	KindSeeds    Kind = "seeds"
+	KindBastions Kind = "bastions"
+)
+
+// outputWide is the output format that prints the table with additional columns.
+const outputWide = "wide"
+
+// options is a struct to support get command.
+type options struct {
+	base.Options
+
+	// Kind is the kind of resources to list
+	Kind Kind
+
+	// Wide defines if additional columns are printed
+	Wide bool
+
+	// CurrentTarget holds the current target configuration
+	CurrentTarget target.Target
+
+	// GardenClient is the client for the garden cluster
+	GardenClient clientgarden.Client
+}
+
+// newOptions returns initialized options.
+func newOptions(ioStreams util.IOStreams, kind Kind) *options {
+	return &options{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+		Kind: kind,
+	}
+}
+
+// AddFlags adds flags to adjust the output to a cobra command.
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "One of 'yaml', 'json' or 'wide'.")
+}
+
+// RegisterCompletionsForOutputFlag adds output flag completion to the command.
+func (o *options) RegisterCompletionsForOutputFlag(cmd *cobra.Command) {
+	utilruntime.Must(cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return o.AllowedOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}))
+}
+
+// AllowedOutputFormats returns the allowed formats for the output flag.
+func (o *options) AllowedOutputFormats() []string {
+	return append(o.Options.AllowedOutputFormats(), outputWide)
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *options) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	if o.Output == outputWide {
+		// the wide table is printed like the default output
+		o.Wide = true
+		o.Output = ""
+	}
+
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		return err
+	}
+
+	if currentTarget.GardenName() == "" {
+		return target.ErrNoGardenTargeted
+	}
+
+	o.CurrentTarget = currentTarget
+
+	gardenClient, err := manager.GardenClient(currentTarget.GardenName())
+	if err != nil {
+		return fmt.Errorf("failed to create garden cluster client: %w", err)
+	}
+
+	o.GardenClient = gardenClient
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *options) Validate() error {
+	if o.Output != "" && o.Output != "yaml" && o.Output != "json" {
+		return errors.New("--output must be either 'yaml', 'json' or 'wide'")
+	}
+
+	return nil
+}
+
+// Run executes the command.
+func (o *options) Run(f util.Factory) error {
+	ctx := f.Context()
+	now := f.Clock().Now()
+
+	var (
+		list  client.ObjectList
+		table *Table
+		err   error
+	)
+
+	switch o.Kind {
+	case KindShoots:
+		list, table, err = o.getShoots(ctx, now)
+	case KindProjects:
+		list, table, err = o.getProjects(ctx, now)
+	case KindSeeds:
+		list, table, err = o.getSeeds(ctx, now)
+	case KindBastions:
+		list, table, err = o.getBastions(ctx, now)
+	default:
+		return fmt.Errorf("unsupported kind %q", o.Kind)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if o.Output != "" {
+		return o.PrintObject(list)
+	}
+
+	if len(table.Rows) == 0 {
+		fmt.Fprintf(o.IOStreams.ErrOut, "No %s found\n", o.Kind)
+		return nil
+	}
+
+	table.Wide = o.Wide
+
+	return o.PrintObject(table)
+}
+
+func (o *options) getShoots(ctx context.Context, now time.Time) (client.ObjectList, *Table, error) {
+	shootList, err := o.GardenClient.ListShoots(ctx, o.CurrentTarget.WithShootName("").AsListOption())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectNames := map[string]string{}
+
+	if len(shootList.Items) > 0 {
+		projectList, err := o.GardenClient.ListProjects(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, project := range projectList.Items {
+			if project.Spec.Namespace != nil {
+				projectNames[*project.Spec.Namespace] = project.Name
+			}
+		}
+	}
+
+	return shootList, newShootTable(shootList.Items, projectNames, now), nil
+}
+
+func (o *options) getProjects(ctx context.Context, now time.Time) (client.ObjectList, *Table, error) {
+	projectList, err := o.GardenClient.ListProjects(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return projectList, newProjectTable(projectList.Items, now), nil
+}
+
+func (o *options) getSeeds(ctx context.Context, now time.Time) (client.ObjectList, *Table, error) {
+	seedList, err := o.GardenClient.ListSeeds(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return seedList, newSeedTable(seedList.Items, now), nil
+}
+
+func (o *options) getBastions(ctx context.Context, now time.Time) (client.ObjectList, *Table, error) {
+	var opts []client.ListOption
+	if o.CurrentTarget.ProjectName() != "" {
+		opts = append(opts, clientgarden.ProjectFilter{"project": o.CurrentTarget.ProjectName()})
+	}
+
+	bastionList, err := o.GardenClient.ListBastions(ctx, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if shootName := o.CurrentTarget.ShootName(); shootName != "" {
+		items := bastionList.Items[:0]
+
+		for _, bastion := range bastionList.Items {
+			if bastion.Spec.ShootRef.Name == shootName {
+				items = append(items, bastion)
+			}
+		}
+
+		bastionList.Items = items
+	}
+
+	return bastionList, NewBastionTable(bastionList.Items, now), nil
+}
@@ -0,0 +1,296 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package get
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+// Table is a table of resources that is printed in a human-readable format.
+type Table struct {
+	metav1beta1.Table
+
+	// Wide defines if the columns with a priority greater than zero are printed
+	Wide bool
+}
+
+var _ fmt.Stringer = &Table{}
+
+// String returns the table in a human-readable format.
+func (t *Table) String() string {
+	buf := bytes.Buffer{}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{Wide: t.Wide})
+	if err := printer.PrintObj(&t.Table, &buf); err != nil {
+		klog.Background().Error(err, "failed to output table")
+		return ""
+	}
+
+	return buf.String()
+}
+
+func newShootTable(shoots []gardencorev1beta1.Shoot, projectNames map[string]string, now time.Time) *Table {
+	table := &Table{
+		Table: metav1beta1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "Project", Type: "string"},
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "Seed", Type: "string"},
+				{Name: "Version", Type: "string"},
+				{Name: "Hibernated", Type: "boolean"},
+				{Name: "Last Operation", Type: "string"},
+				{Name: "Age", Type: "string"},
+				{Name: "Provider", Type: "string", Priority: 1},
+				{Name: "Region", Type: "string", Priority: 1},
+				{Name: "Purpose", Type: "string", Priority: 1},
+				{Name: "Status", Type: "string", Priority: 1},
+			},
+			Rows: []metav1.TableRow{},
+		},
+	}
+
+	for _, shoot := range shoots {
+		project, ok := projectNames[shoot.Namespace]
+		if !ok {
+			project = util.None
+		}
+
+		hibernated := shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.Enabled, false)
+
+		purpose := util.None
+		if shoot.Spec.Purpose != nil {
+			purpose = string(*shoot.Spec.Purpose)
+		}
+
+		status, ok := shoot.Labels[v1beta1constants.ShootStatus]
+		if !ok {
+			status = "unknown"
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				project,
+				shoot.Name,
+				util.ValueOrNone(ptr.Deref(shoot.Spec.SeedName, "")),
+				shoot.Spec.Kubernetes.Version,
+				hibernated,
+				lastOperation(shoot.Status.LastOperation),
+				age(shoot.CreationTimestamp, now),
+				util.ValueOrNone(shoot.Spec.Provider.Type),
+				util.ValueOrNone(shoot.Spec.Region),
+				purpose,
+				status,
+			},
+		})
+	}
+
+	return table
+}
+
+func newProjectTable(projects []gardencorev1beta1.Project, now time.Time) *Table {
+	table := &Table{
+		Table: metav1beta1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "Namespace", Type: "string"},
+				{Name: "Status", Type: "string"},
+				{Name: "Age", Type: "string"},
+				{Name: "Owner", Type: "string", Priority: 1},
+				{Name: "Purpose", Type: "string", Priority: 1},
+			},
+			Rows: []metav1.TableRow{},
+		},
+	}
+
+	for _, project := range projects {
+		owner := util.None
+		if project.Spec.Owner != nil {
+			owner = project.Spec.Owner.Name
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				project.Name,
+				util.ValueOrNone(ptr.Deref(project.Spec.Namespace, "")),
+				util.ValueOrNone(string(project.Status.Phase)),
+				age(project.CreationTimestamp, now),
+				owner,
+				util.ValueOrNone(ptr.Deref(project.Spec.Purpose, "")),
+			},
+		})
+	}
+
+	return table
+}
+
+func newSeedTable(seeds []gardencorev1beta1.Seed, now time.Time) *Table {
+	table := &Table{
+		Table: metav1beta1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "Status", Type: "string"},
+				{Name: "Provider", Type: "string"},
+				{Name: "Region", Type: "string"},
+				{Name: "Version", Type: "string"},
+				{Name: "Age", Type: "string"},
+				{Name: "Gardener Version", Type: "string", Priority: 1},
+				{Name: "Last Operation", Type: "string", Priority: 1},
+			},
+			Rows: []metav1.TableRow{},
+		},
+	}
+
+	for _, seed := range seeds {
+		gardenerVersion := util.None
+		if seed.Status.Gardener != nil {
+			gardenerVersion = util.ValueOrNone(seed.Status.Gardener.Version)
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				seed.Name,
+				conditionStatus(corev1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady), "Ready", "NotReady"),
+				util.ValueOrNone(seed.Spec.Provider.Type),
+				util.ValueOrNone(seed.Spec.Provider.Region),
+				util.ValueOrNone(ptr.Deref(seed.Status.KubernetesVersion, "")),
+				age(seed.CreationTimestamp, now),
+				gardenerVersion,
+				lastOperation(seed.Status.LastOperation),
+			},
+		})
+	}
+
+	return table
+}
+
+// NewBastionTable returns the table of the given bastions.
+func NewBastionTable(bastions []operationsv1alpha1.Bastion, now time.Time) *Table {
+	table := &Table{
+		Table: metav1beta1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "Name", Type: "string", Format: "name"},
+				{Name: "Namespace", Type: "string"},
+				{Name: "Shoot", Type: "string"},
+				{Name: "Created By", Type: "string"},
+				{Name: "Ready", Type: "string"},
+				{Name: "Address", Type: "string"},
+				{Name: "CIDRs", Type: "string"},
+				{Name: "Expires", Type: "string"},
+				{Name: "Age", Type: "string"},
+				{Name: "Seed", Type: "string", Priority: 1},
+			},
+			Rows: []metav1.TableRow{},
+		},
+	}
+
+	for _, bastion := range bastions {
+		expires := util.None
+		if bastion.Status.ExpirationTimestamp != nil {
+			expires = bastion.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				bastion.Name,
+				bastion.Namespace,
+				bastion.Spec.ShootRef.Name,
+				util.ValueOrNone(bastion.Annotations[v1beta1constants.GardenCreatedBy]),
+				BastionReadyStatus(bastion),
+				BastionAddress(bastion),
+				BastionCIDRs(bastion),
+				expires,
+				age(bastion.CreationTimestamp, now),
+				util.ValueOrNone(ptr.Deref(bastion.Spec.SeedName, "")),
+			},
+		})
+	}
+
+	return table
+}
+
+// BastionReadyStatus returns "True" if the bastion is ready, "False" if it is not and "Unknown" otherwise.
+func BastionReadyStatus(bastion operationsv1alpha1.Bastion) string {
+	return conditionStatus(corev1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady), "True", "False")
+}
+
+// BastionAddress returns the IP address or hostname of the bastion.
+func BastionAddress(bastion operationsv1alpha1.Bastion) string {
+	if ingress := bastion.Status.Ingress; ingress != nil {
+		switch {
+		case ingress.IP != "":
+			return ingress.IP
+		case ingress.Hostname != "":
+			return ingress.Hostname
+		}
+	}
+
+	return util.None
+}
+
+// BastionCIDRs returns the comma-separated CIDRs that are allowed to access the bastion.
+func BastionCIDRs(bastion operationsv1alpha1.Bastion) string {
+	cidrs := make([]string, 0, len(bastion.Spec.Ingress))
+	for _, policy := range bastion.Spec.Ingress {
+		cidrs = append(cidrs, policy.IPBlock.CIDR)
+	}
+
+	return util.ValueOrNone(strings.Join(cidrs, ","))
+}
+
+// lastOperation returns a short description of the last operation, e.g. "Reconcile Processing (42%)".
+func lastOperation(op *gardencorev1beta1.LastOperation) string {
+	if op == nil {
+		return util.None
+	}
+
+	if op.State == gardencorev1beta1.LastOperationStateSucceeded {
+		return fmt.Sprintf("%s %s", op.Type, op.State)
+	}
+
+	return fmt.Sprintf("%s %s (%d%%)", op.Type, op.State, op.Progress)
+}
+
+// conditionStatus returns ready if the condition is true, notReady if it is false and "Unknown" otherwise.
+func conditionStatus(condition *gardencorev1beta1.Condition, ready, notReady string) string {
+	if condition == nil {
+		return "Unknown"
+	}
+
+	switch condition.Status {
+	case gardencorev1beta1.ConditionTrue:
+		return ready
+	case gardencorev1beta1.ConditionFalse:
+		return notReady
+	default:
+		return string(condition.Status)
+	}
+}
+
+// age returns the human-readable duration since the given creation timestamp.
+func age(creationTimestamp metav1.Time, now time.Time) string {
+	if creationTimestamp.IsZero() {
+		return util.None
+	}
+
+	return duration.HumanDuration(now.Sub(creationTimestamp.Time))
+}
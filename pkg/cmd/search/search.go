@@ -0,0 +1,57 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+)
+
+// NewCmdSearch returns a new search command.
+func NewCmdSearch(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search resources across all configured gardens",
+		Long:  "Search resources across all gardens that are defined in the gardenctl configuration",
+	}
+
+	cmd.AddCommand(NewCmdSearchShoot(f, ioStreams))
+
+	return cmd
+}
+
+// NewCmdSearchShoot returns a new search shoot command.
+func NewCmdSearchShoot(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := NewShootOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:   "shoot PATTERN",
+		Short: "Search shoots by name across all configured gardens",
+		Long: `Search shoots by name across all gardens that are defined in the gardenctl configuration.
+The gardens are searched in parallel. By default, the pattern is a glob pattern that has to match the whole shoot name.
+With --regex the pattern is interpreted as regular expression, which matches if any part of the shoot name matches.`,
+		Example: `# search shoots named "my-shoot" in all gardens
+gardenctl search shoot my-shoot
+
+# search shoots with a name that starts with "my-" in all gardens
+gardenctl search shoot 'my-*'
+
+# search shoots with a regular expression and print the result as JSON
+gardenctl search shoot '^(dev|prod)-.+$' --regex -o json
+
+# search the shoot named "my-shoot" and target it if it is unique
+gardenctl search shoot my-shoot --target`,
+		Args: cobra.ExactArgs(1),
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	return cmd
+}
@@ -0,0 +1,332 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/ac"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// ShootMatch is a shoot that matches the search pattern.
+type ShootMatch struct {
+	// Garden is the name of the garden the shoot belongs to
+	Garden string `json:"garden"`
+	// Project is the name of the project the shoot belongs to
+	Project string `json:"project,omitempty"`
+	// Namespace is the namespace of the shoot
+	Namespace string `json:"namespace"`
+	// Name is the name of the shoot
+	Name string `json:"name"`
+	// Seed is the name of the seed the shoot is scheduled to
+	Seed string `json:"seed,omitempty"`
+	// Status is the health status of the shoot
+	Status string `json:"status"`
+}
+
+// ShootOptions is a struct to support search shoot command.
+type ShootOptions struct {
+	base.Options
+
+	// Pattern is the glob pattern or regular expression shoot names are matched against
+	Pattern string
+	// Regex defines if the pattern is a regular expression
+	Regex bool
+	// Target defines if the matching shoot should be targeted
+	Target bool
+
+	// matches returns true if the given shoot name matches the pattern
+	matches func(name string) bool
+}
+
+// NewShootOptions returns initialized ShootOptions.
+func NewShootOptions(ioStreams util.IOStreams) *ShootOptions {
+	return &ShootOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *ShootOptions) AddFlags(flags *pflag.FlagSet) {
+	o.Options.AddFlags(flags)
+
+	flags.BoolVar(&o.Regex, "regex", false, "Interpret the pattern as regular expression instead of a glob pattern")
+	flags.BoolVar(&o.Target, "target", false, "Target the matching shoot. Fails if the pattern does not match exactly one shoot")
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *ShootOptions) Complete(_ util.Factory, _ *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Pattern = strings.TrimSpace(args[0])
+	}
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *ShootOptions) Validate() error {
+	if o.Pattern == "" {
+		return errors.New("pattern is required")
+	}
+
+	if o.Regex {
+		r, err := regexp.Compile(o.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression %q: %w", o.Pattern, err)
+		}
+
+		o.matches = r.MatchString
+	} else {
+		if _, err := path.Match(o.Pattern, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern %q: %w", o.Pattern, err)
+		}
+
+		o.matches = func(name string) bool {
+			ok, _ := path.Match(o.Pattern, name)
+			return ok
+		}
+	}
+
+	return o.Options.Validate()
+}
+
+// Run executes the command.
+func (o *ShootOptions) Run(f util.Factory) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	ctx := f.Context()
+
+	shoots, err := o.searchGardens(ctx, manager)
+	if err != nil {
+		return err
+	}
+
+	if o.Target {
+		return o.targetShoot(ctx, f, manager, shoots)
+	}
+
+	if o.Output != "" {
+		return o.PrintObject(shoots)
+	}
+
+	if len(shoots) == 0 {
+		fmt.Fprintf(o.IOStreams.Out, "No shoots found matching %q\n", o.Pattern)
+		return nil
+	}
+
+	return printShootTable(o.IOStreams, shoots)
+}
+
+// searchGardens searches all configured gardens in parallel. Gardens that cannot be searched are reported
+// as warning. An error is only returned if none of the gardens could be searched.
+func (o *ShootOptions) searchGardens(ctx context.Context, manager target.Manager) ([]ShootMatch, error) {
+	gardens := manager.Configuration().Gardens
+	if len(gardens) == 0 {
+		return nil, errors.New("no gardens defined in gardenctl configuration")
+	}
+
+	type result struct {
+		shoots []ShootMatch
+		err    error
+	}
+
+	results := make([]result, len(gardens))
+
+	var wg sync.WaitGroup
+
+	for i, garden := range gardens {
+		wg.Add(1)
+
+		go func(i int, gardenName string) {
+			defer wg.Done()
+
+			shoots, err := o.searchGarden(ctx, manager, gardenName)
+			results[i] = result{shoots: shoots, err: err}
+		}(i, garden.Name)
+	}
+
+	wg.Wait()
+
+	shoots := []ShootMatch{}
+
+	var errs []error
+
+	for i, r := range results {
+		if r.err != nil {
+			err := fmt.Errorf("failed to search garden %q: %w", gardens[i].Name, r.err)
+			fmt.Fprintf(o.IOStreams.ErrOut, "%s %v\n", color.YellowString("WARN"), err)
+			errs = append(errs, err)
+
+			continue
+		}
+
+		shoots = append(shoots, r.shoots...)
+	}
+
+	if len(errs) == len(gardens) {
+		return nil, errors.Join(errs...)
+	}
+
+	sort.SliceStable(shoots, func(i, j int) bool {
+		a, b := shoots[i], shoots[j]
+		if a.Garden != b.Garden {
+			return a.Garden < b.Garden
+		}
+
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+
+		return a.Name < b.Name
+	})
+
+	return shoots, nil
+}
+
+// searchGarden returns all shoots of a garden that match the pattern.
+func (o *ShootOptions) searchGarden(ctx context.Context, manager target.Manager, gardenName string) ([]ShootMatch, error) {
+	gardenClient, err := manager.GardenClient(gardenName)
+	if err != nil {
+		return nil, err
+	}
+
+	shootList, err := gardenClient.ListShoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var shoots []gardencorev1beta1.Shoot
+
+	for _, shoot := range shootList.Items {
+		if o.matches(shoot.Name) {
+			shoots = append(shoots, shoot)
+		}
+	}
+
+	if len(shoots) == 0 {
+		return nil, nil
+	}
+
+	projectList, err := gardenClient.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectNames := make(map[string]string, len(projectList.Items))
+	for _, project := range projectList.Items {
+		if project.Spec.Namespace != nil {
+			projectNames[*project.Spec.Namespace] = project.Name
+		}
+	}
+
+	matches := make([]ShootMatch, 0, len(shoots))
+	for _, shoot := range shoots {
+		matches = append(matches, ShootMatch{
+			Garden:    gardenName,
+			Project:   projectNames[shoot.Namespace],
+			Namespace: shoot.Namespace,
+			Name:      shoot.Name,
+			Seed:      ptr.Deref(shoot.Spec.SeedName, ""),
+			Status:    shootStatus(&shoot),
+		})
+	}
+
+	return matches, nil
+}
+
+// targetShoot targets the only shoot that matches the pattern.
+func (o *ShootOptions) targetShoot(ctx context.Context, f util.Factory, manager target.Manager, shoots []ShootMatch) error {
+	switch len(shoots) {
+	case 0:
+		return fmt.Errorf("no shoot found matching %q", o.Pattern)
+	case 1:
+		// unique match
+	default:
+		if err := printShootTable(o.IOStreams, shoots); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("pattern %q matches %d shoots, refine the pattern to target a shoot", o.Pattern, len(shoots))
+	}
+
+	shoot := shoots[0]
+	if shoot.Project == "" {
+		return fmt.Errorf("failed to determine project of shoot %q in namespace %q", shoot.Name, shoot.Namespace)
+	}
+
+	handler := ac.NewAccessRestrictionHandler(o.IOStreams.In, o.IOStreams.Out, true)
+	ctx = ac.WithAccessRestrictionHandler(ctx, handler)
+
+	if err := manager.SetTarget(ctx, target.NewTarget(shoot.Garden, shoot.Project, "", shoot.Name)); err != nil {
+		return err
+	}
+
+	if o.Output != "" {
+		return o.PrintObject(shoot)
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "Successfully targeted shoot %q of project %q in garden %q\n", shoot.Name, shoot.Project, shoot.Garden)
+
+	return nil
+}
+
+func printShootTable(ioStreams util.IOStreams, shoots []ShootMatch) error {
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Garden", Type: "string"},
+			{Name: "Project", Type: "string"},
+			{Name: "Shoot", Type: "string"},
+			{Name: "Seed", Type: "string"},
+			{Name: "Status", Type: "string"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+
+	for _, shoot := range shoots {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{shoot.Garden, shoot.Project, shoot.Name, shoot.Seed, shoot.Status},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+
+	return printer.PrintObj(table, ioStreams.Out)
+}
+
+// shootStatus returns the health status of a shoot as maintained by the gardener in the shoot status label.
+func shootStatus(shoot *gardencorev1beta1.Shoot) string {
+	if status, ok := shoot.Labels[v1beta1constants.ShootStatus]; ok {
+		return status
+	}
+
+	return "unknown"
+}
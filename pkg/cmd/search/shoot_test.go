@@ -0,0 +1,182 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/search"
+	"github.com/gardener/gardenctl-v2/pkg/config"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+func newProject(name string) *gardencorev1beta1.Project {
+	return &gardencorev1beta1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: gardencorev1beta1.ProjectSpec{
+			Namespace: ptr.To("garden-" + name),
+		},
+	}
+}
+
+func newShoot(name, projectName, seedName, status string) *gardencorev1beta1.Shoot {
+	return &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "garden-" + projectName,
+			Labels: map[string]string{
+				"shoot.gardener.cloud/status": status,
+			},
+		},
+		Spec: gardencorev1beta1.ShootSpec{
+			SeedName: ptr.To(seedName),
+		},
+	}
+}
+
+var _ = Describe("Search Shoot Command", func() {
+	var (
+		ctrl    *gomock.Controller
+		factory *utilmocks.MockFactory
+		manager *targetmocks.MockManager
+		streams util.IOStreams
+		out     *util.SafeBytesBuffer
+		errOut  *util.SafeBytesBuffer
+		cfg     *config.Config
+		cmd     *cobra.Command
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+
+		factory.EXPECT().Context().Return(context.Background()).AnyTimes()
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+
+		cfg = &config.Config{
+			Gardens: []config.Garden{{Name: "garden1"}, {Name: "garden2"}},
+		}
+		manager.EXPECT().Configuration().Return(cfg).AnyTimes()
+
+		garden1Client := clientgarden.NewClient(nil, fake.NewClientWithObjects(
+			newProject("dev"),
+			newProject("prod"),
+			newShoot("my-shoot", "dev", "seed-a", "healthy"),
+			newShoot("other", "dev", "seed-a", "healthy"),
+		), "garden1")
+		garden2Client := clientgarden.NewClient(nil, fake.NewClientWithObjects(
+			newProject("ops"),
+			newShoot("my-shoot-2", "ops", "seed-b", "unhealthy"),
+		), "garden2")
+		manager.EXPECT().GardenClient("garden1").Return(garden1Client, nil).AnyTimes()
+		manager.EXPECT().GardenClient("garden2").Return(garden2Client, nil).AnyTimes()
+
+		streams, _, out, errOut = util.NewTestIOStreams()
+		cmd = search.NewCmdSearchShoot(factory, streams)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should search shoots in all gardens with a glob pattern", func() {
+		Expect(cmd.RunE(cmd, []string{"my-*"})).To(Succeed())
+		Expect(out.String()).To(MatchRegexp(`GARDEN\s+PROJECT\s+SHOOT\s+SEED\s+STATUS\n` +
+			`garden1\s+dev\s+my-shoot\s+seed-a\s+healthy\n` +
+			`garden2\s+ops\s+my-shoot-2\s+seed-b\s+unhealthy\n`))
+	})
+
+	It("should match the whole name with a glob pattern", func() {
+		Expect(cmd.RunE(cmd, []string{"my-shoot"})).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("my-shoot"))
+		Expect(out.String()).NotTo(ContainSubstring("my-shoot-2"))
+	})
+
+	It("should search shoots with a regular expression and print json", func() {
+		Expect(cmd.Flags().Set("regex", "true")).To(Succeed())
+		Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+
+		Expect(cmd.RunE(cmd, []string{"shoot-\\d$"})).To(Succeed())
+		Expect(out.String()).To(MatchJSON(`[{
+			"garden": "garden2",
+			"project": "ops",
+			"namespace": "garden-ops",
+			"name": "my-shoot-2",
+			"seed": "seed-b",
+			"status": "unhealthy"
+		}]`))
+	})
+
+	It("should report when nothing matches", func() {
+		Expect(cmd.RunE(cmd, []string{"does-not-exist"})).To(Succeed())
+		Expect(out.String()).To(Equal("No shoots found matching \"does-not-exist\"\n"))
+	})
+
+	It("should reject an invalid regular expression", func() {
+		Expect(cmd.Flags().Set("regex", "true")).To(Succeed())
+
+		Expect(cmd.RunE(cmd, []string{"("})).To(MatchError(ContainSubstring("invalid regular expression")))
+	})
+
+	Context("when a garden cannot be searched", func() {
+		BeforeEach(func() {
+			cfg.Gardens = append(cfg.Gardens, config.Garden{Name: "broken"})
+			manager.EXPECT().GardenClient("broken").Return(nil, errors.New("boom")).AnyTimes()
+		})
+
+		It("should warn and continue with the other gardens", func() {
+			Expect(cmd.RunE(cmd, []string{"my-shoot"})).To(Succeed())
+			Expect(errOut.String()).To(ContainSubstring(`failed to search garden "broken": boom`))
+			Expect(out.String()).To(ContainSubstring("my-shoot"))
+		})
+
+		It("should fail if no garden can be searched", func() {
+			cfg.Gardens = []config.Garden{{Name: "broken"}}
+
+			Expect(cmd.RunE(cmd, []string{"my-shoot"})).To(MatchError(`failed to search garden "broken": boom`))
+		})
+	})
+
+	Describe("targeting the match", func() {
+		BeforeEach(func() {
+			Expect(cmd.Flags().Set("target", "true")).To(Succeed())
+		})
+
+		It("should target a unique match", func() {
+			manager.EXPECT().SetTarget(gomock.Any(), target.NewTarget("garden1", "dev", "", "my-shoot")).Return(nil)
+
+			Expect(cmd.RunE(cmd, []string{"my-shoot"})).To(Succeed())
+			Expect(out.String()).To(Equal(fmt.Sprintf("Successfully targeted shoot %q of project %q in garden %q\n", "my-shoot", "dev", "garden1")))
+		})
+
+		It("should fail if the pattern is ambiguous", func() {
+			Expect(cmd.RunE(cmd, []string{"my-*"})).To(MatchError(`pattern "my-*" matches 2 shoots, refine the pattern to target a shoot`))
+		})
+
+		It("should fail if nothing matches", func() {
+			Expect(cmd.RunE(cmd, []string{"nothing"})).To(MatchError(`no shoot found matching "nothing"`))
+		})
+	})
+})
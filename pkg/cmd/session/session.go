@@ -0,0 +1,215 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package session
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+)
+
+// NewCmdSession returns a new session command.
+func NewCmdSession(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "Manage the gardenctl session directories",
+		Long: `Manage the gardenctl session directories.
+Every shell session has its own session directory below ${TMPDIR}/garden/sessions, which contains the target, the target history and the temporary kubeconfig files.
+Inactive session directories can be removed with "gardenctl session clean". To remove them automatically, set "session.autoClean" to true in the gardenctl configuration.
+The time after which a session is considered inactive can be configured with "session.ttl" and defaults to 168h.`,
+	}
+
+	cmd.AddCommand(NewCmdSessionList(f, ioStreams))
+	cmd.AddCommand(NewCmdSessionClean(f, ioStreams))
+
+	return cmd
+}
+
+// NewCmdSessionList returns a new session list command.
+func NewCmdSessionList(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &SessionListOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all session directories",
+		Example: `# list all session directories
+gardenctl session list
+
+# list all session directories in JSON format
+gardenctl session list -o json`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	return cmd
+}
+
+// SessionListOptions is a struct to support session list command.
+type SessionListOptions struct {
+	base.Options
+	// SessionDirectory is the directory of the current session
+	SessionDirectory string
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *SessionListOptions) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	o.SessionDirectory = manager.SessionDir()
+
+	return nil
+}
+
+// Run executes the command.
+func (o *SessionListOptions) Run(f util.Factory) error {
+	sessions, err := util.ListSessions(filepath.Dir(o.SessionDirectory), filepath.Base(o.SessionDirectory))
+	if err != nil {
+		return err
+	}
+
+	if o.Output != "" {
+		return o.PrintObject(sessions)
+	}
+
+	now := f.Clock().Now()
+
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Current", Type: "string"},
+			{Name: "ID", Type: "string"},
+			{Name: "Last Activity", Type: "string"},
+			{Name: "Kubeconfigs", Type: "integer"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+
+	for _, session := range sessions {
+		current := ""
+		if session.Current {
+			current = "*"
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{current, session.ID, duration.HumanDuration(now.Sub(session.LastActivity)) + " ago", session.Kubeconfigs},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+
+	return printer.PrintObj(table, o.IOStreams.Out)
+}
+
+// NewCmdSessionClean returns a new session clean command.
+func NewCmdSessionClean(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &SessionCleanOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "clean",
+		Short: "Remove inactive session directories and stale files",
+		Long: `Remove session directories that have been inactive for longer than the TTL. The directory of the current session is never removed.
+In addition, kubeconfig files that are no longer linked by the kubeconfig.yaml symlink and provider configuration directories are removed from all remaining sessions if they have not been used for longer than the TTL.`,
+		Example: `# remove inactive sessions using the TTL of the gardenctl configuration
+gardenctl session clean
+
+# show what would be removed if the TTL was one day
+gardenctl session clean --ttl 24h --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	cmd.Flags().DurationVar(&o.TTL, "ttl", 0, "Time after which sessions and files are considered inactive. Defaults to the TTL of the gardenctl configuration (168h if not configured)")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", false, "Only print what would be removed")
+
+	return cmd
+}
+
+// SessionCleanOptions is a struct to support session clean command.
+type SessionCleanOptions struct {
+	base.Options
+	// SessionDirectory is the directory of the current session
+	SessionDirectory string
+	// TTL is the time after which sessions and files are considered inactive
+	TTL time.Duration
+	// DryRun defines if the paths are only printed instead of removed
+	DryRun bool
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *SessionCleanOptions) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	o.SessionDirectory = manager.SessionDir()
+
+	if o.TTL == 0 {
+		cfg := manager.Configuration()
+		if cfg == nil {
+			return errors.New("failed to get configuration")
+		}
+
+		o.TTL = cfg.SessionTTL()
+	}
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *SessionCleanOptions) Validate() error {
+	if o.TTL < 0 {
+		return errors.New("--ttl must not be negative")
+	}
+
+	return nil
+}
+
+// Run executes the command.
+func (o *SessionCleanOptions) Run(f util.Factory) error {
+	paths, err := util.CleanSessions(filepath.Dir(o.SessionDirectory), filepath.Base(o.SessionDirectory), o.TTL, f.Clock().Now(), o.DryRun)
+
+	verb := "Removed"
+	if o.DryRun {
+		verb = "Would remove"
+	}
+
+	for _, path := range paths {
+		fmt.Fprintf(o.IOStreams.Out, "%s %s\n", verb, path)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(paths) == 0 {
+		fmt.Fprintf(o.IOStreams.Out, "No sessions or files inactive for more than %s found\n", o.TTL)
+	}
+
+	return nil
+}
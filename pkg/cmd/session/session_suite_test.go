@@ -0,0 +1,19 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package session_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSessionCommand(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Session Command Test Suite")
+}
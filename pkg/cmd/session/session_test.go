@@ -0,0 +1,132 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package session_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/session"
+	"github.com/gardener/gardenctl-v2/pkg/config"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("Session Command", func() {
+	var (
+		ctrl        *gomock.Controller
+		factory     *utilmocks.MockFactory
+		manager     *targetmocks.MockManager
+		clock       *utilmocks.MockClock
+		streams     util.IOStreams
+		out         *util.SafeBytesBuffer
+		cfg         *config.Config
+		sessionsDir string
+		now         time.Time
+	)
+
+	createSession := func(id string, lastActivity time.Time) {
+		dir := filepath.Join(sessionsDir, id)
+		file := filepath.Join(dir, "target.yaml")
+		Expect(os.MkdirAll(dir, 0o700)).To(Succeed())
+		Expect(os.WriteFile(file, nil, 0o600)).To(Succeed())
+		Expect(os.Chtimes(file, lastActivity, lastActivity)).To(Succeed())
+		Expect(os.Chtimes(dir, lastActivity, lastActivity)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+		clock = utilmocks.NewMockClock(ctrl)
+
+		now = time.Now().Truncate(time.Second)
+		sessionsDir = filepath.Join(GinkgoT().TempDir(), util.SessionsDirectoryName)
+		cfg = &config.Config{
+			Session: &config.SessionConfig{TTL: &metav1.Duration{Duration: 48 * time.Hour}},
+		}
+
+		createSession("current", now.Add(-10*24*time.Hour))
+		createSession("recent", now.Add(-time.Hour))
+		createSession("stale", now.Add(-3*24*time.Hour))
+
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+		factory.EXPECT().Clock().Return(clock).AnyTimes()
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		manager.EXPECT().SessionDir().Return(filepath.Join(sessionsDir, "current")).AnyTimes()
+		manager.EXPECT().Configuration().DoAndReturn(func() *config.Config {
+			return cfg
+		}).AnyTimes()
+
+		streams, _, out, _ = util.NewTestIOStreams()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("list", func() {
+		It("should print a table of all sessions", func() {
+			cmd := session.NewCmdSessionList(factory, streams)
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(out.String()).To(MatchRegexp(`CURRENT\s+ID\s+LAST ACTIVITY\s+KUBECONFIGS\n` +
+				`\*\s+current\s+10d ago\s+0\n` +
+				`\s+recent\s+60m ago\s+0\n` +
+				`\s+stale\s+3d ago\s+0\n`))
+		})
+
+		It("should print the sessions as json", func() {
+			cmd := session.NewCmdSessionList(factory, streams)
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring(`"id": "recent"`))
+			Expect(out.String()).To(ContainSubstring(`"current": true`))
+		})
+	})
+
+	Describe("clean", func() {
+		It("should remove inactive sessions using the configured TTL", func() {
+			cmd := session.NewCmdSessionClean(factory, streams)
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(out.String()).To(Equal("Removed " + filepath.Join(sessionsDir, "stale") + "\n"))
+			Expect(filepath.Join(sessionsDir, "stale")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(sessionsDir, "current")).To(BeADirectory())
+			Expect(filepath.Join(sessionsDir, "recent")).To(BeADirectory())
+		})
+
+		It("should only print the sessions that would be removed in dry-run mode", func() {
+			cmd := session.NewCmdSessionClean(factory, streams)
+			Expect(cmd.Flags().Set("ttl", "30m")).To(Succeed())
+			Expect(cmd.Flags().Set("dry-run", "true")).To(Succeed())
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(out.String()).To(Equal("Would remove " + filepath.Join(sessionsDir, "recent") + "\n" +
+				"Would remove " + filepath.Join(sessionsDir, "stale") + "\n"))
+			Expect(filepath.Join(sessionsDir, "stale")).To(BeADirectory())
+		})
+
+		It("should use the default TTL if none is configured", func() {
+			cfg.Session = nil
+
+			cmd := session.NewCmdSessionClean(factory, streams)
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(out.String()).To(Equal("No sessions or files inactive for more than 168h0m0s found\n"))
+		})
+
+		It("should fail for a negative TTL", func() {
+			cmd := session.NewCmdSessionClean(factory, streams)
+			Expect(cmd.Flags().Set("ttl", "-1h")).To(Succeed())
+			Expect(cmd.RunE(cmd, nil)).To(MatchError("--ttl must not be negative"))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/spf13/pflag"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/status"
+)
+
+// HibernationOptions is a struct to support the hibernate and wake-up commands.
+type HibernationOptions struct {
+	options
+
+	// Hibernate is true if the shoot is hibernated and false if it is woken up
+	Hibernate bool
+
+	// Wait defines if the command blocks until the last operation of the shoot finished
+	Wait bool
+
+	// Timeout is the maximum time to wait for the last operation to finish
+	Timeout time.Duration
+}
+
+// NewHibernationOptions returns initialized HibernationOptions.
+func NewHibernationOptions(ioStreams util.IOStreams, hibernate bool) *HibernationOptions {
+	o := &HibernationOptions{
+		Hibernate: hibernate,
+		Timeout:   30 * time.Minute,
+	}
+	o.IOStreams = ioStreams
+
+	return o
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *HibernationOptions) AddFlags(flags *pflag.FlagSet) {
+	o.options.AddFlags(flags)
+
+	flags.BoolVar(&o.Wait, "wait", o.Wait, "Wait until the last operation of the shoot finished. The progress of the operation is streamed while waiting")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the last operation to finish")
+}
+
+// Validate validates the provided options.
+func (o *HibernationOptions) Validate() error {
+	if o.Wait && o.Timeout <= 0 {
+		return errors.New("--timeout must be greater than zero")
+	}
+
+	return o.options.Validate()
+}
+
+// Run executes the command.
+func (o *HibernationOptions) Run(f util.Factory) error {
+	ctx := f.Context()
+
+	shoot, err := o.findShoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	if isHibernationEnabled(shoot) == o.Hibernate && shoot.Status.IsHibernated == o.Hibernate {
+		fmt.Fprintf(o.IOStreams.Out, "Shoot %q is already %s\n", shoot.Name, o.state())
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+
+	if shoot.Spec.Hibernation == nil {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+	}
+
+	shoot.Spec.Hibernation.Enabled = ptr.To(o.Hibernate)
+
+	if err := o.GardenClient.RuntimeClient().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed to %s shoot %q: %w", o.action(), shoot.Name, err)
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "Successfully requested to %s shoot %q\n", o.action(), shoot.Name)
+
+	if !o.Wait {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	shoot, err = status.WaitForOperation(ctx, o.GardenClient.RuntimeClient(), shoot, o.IOStreams.Out)
+	if err != nil {
+		return err
+	}
+
+	if op := shoot.Status.LastOperation; op.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return fmt.Errorf("failed to %s shoot %q: last operation %s %s", o.action(), shoot.Name, op.Type, op.State)
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "Shoot %q is %s\n", shoot.Name, o.state())
+
+	return nil
+}
+
+func (o *HibernationOptions) action() string {
+	if o.Hibernate {
+		return "hibernate"
+	}
+
+	return "wake up"
+}
+
+func (o *HibernationOptions) state() string {
+	if o.Hibernate {
+		return "hibernated"
+	}
+
+	return "awake"
+}
+
+func isHibernationEnabled(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.Enabled, false)
+}
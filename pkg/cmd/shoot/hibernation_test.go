@@ -0,0 +1,234 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package shoot_test
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/ac"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/shoot"
+	"github.com/gardener/gardenctl-v2/pkg/config"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("Shoot Hibernation Commands", func() {
+	const gardenName = "mygarden"
+
+	var (
+		ctrl          *gomock.Controller
+		factory       *utilmocks.MockFactory
+		manager       *targetmocks.MockManager
+		streams       util.IOStreams
+		in            *util.SafeBytesBuffer
+		out           *util.SafeBytesBuffer
+		errOut        *util.SafeBytesBuffer
+		shootObj      *gardencorev1beta1.Shoot
+		runtimeClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+
+		cfg := &config.Config{
+			Gardens: []config.Garden{{
+				Name: gardenName,
+				AccessRestrictions: []ac.AccessRestriction{{
+					Key: "eu-access-only",
+					Msg: "Do not access this shoot from outside the EU",
+				}},
+			}},
+		}
+
+		shootObj = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myshoot",
+				Namespace: "garden-prod",
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:     gardencorev1beta1.LastOperationTypeReconcile,
+					State:    gardencorev1beta1.LastOperationStateSucceeded,
+					Progress: 100,
+				},
+			},
+		}
+
+		factory.EXPECT().Context().Return(context.Background()).AnyTimes()
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+		factory.EXPECT().TargetFlags().Return(target.NewTargetFlags("", "", "", "", false)).AnyTimes()
+		manager.EXPECT().Configuration().Return(cfg).AnyTimes()
+		manager.EXPECT().CurrentTarget().Return(target.NewTarget(gardenName, "prod", "", "myshoot"), nil).AnyTimes()
+
+		streams, in, out, errOut = util.NewTestIOStreams()
+	})
+
+	JustBeforeEach(func() {
+		runtimeClient = fake.NewClientWithObjects(
+			&gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-prod")},
+			},
+			shootObj,
+		)
+		manager.EXPECT().GardenClient(gardenName).Return(clientgarden.NewClient(nil, runtimeClient, gardenName), nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	run := func(args ...string) error {
+		cmd := shoot.NewCmdShoot(factory, streams)
+		cmd.SetArgs(args)
+		cmd.SetOut(out)
+		cmd.SetErr(errOut)
+
+		return cmd.Execute()
+	}
+
+	getShoot := func() *gardencorev1beta1.Shoot {
+		s := &gardencorev1beta1.Shoot{}
+		ExpectWithOffset(1, runtimeClient.Get(context.Background(), client.ObjectKeyFromObject(shootObj), s)).To(Succeed())
+
+		return s
+	}
+
+	It("should enable the hibernation of the shoot", func() {
+		Expect(run("hibernate")).To(Succeed())
+		Expect(getShoot().Spec.Hibernation.Enabled).To(Equal(ptr.To(true)))
+		Expect(out.String()).To(Equal("Successfully requested to hibernate shoot \"myshoot\"\n"))
+	})
+
+	Context("when the shoot is hibernated", func() {
+		BeforeEach(func() {
+			shootObj.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			shootObj.Status.IsHibernated = true
+		})
+
+		It("should disable the hibernation of the shoot", func() {
+			Expect(run("wake-up")).To(Succeed())
+			Expect(getShoot().Spec.Hibernation.Enabled).To(Equal(ptr.To(false)))
+			Expect(out.String()).To(Equal("Successfully requested to wake up shoot \"myshoot\"\n"))
+		})
+
+		It("should not modify the shoot if it is already hibernated", func() {
+			Expect(run("hibernate")).To(Succeed())
+			Expect(out.String()).To(Equal("Shoot \"myshoot\" is already hibernated\n"))
+		})
+	})
+
+	Context("when waiting for the operation", func() {
+		updateLastOperation := func(state gardencorev1beta1.LastOperationState, progress int32) {
+			current := getShoot()
+			current.Status.LastOperation.State = state
+			current.Status.LastOperation.Progress = progress
+			ExpectWithOffset(1, runtimeClient.Update(context.Background(), current)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			// the gardener did not yet observe the hibernation request
+			shootObj.Generation = 2
+			shootObj.Status.ObservedGeneration = 1
+		})
+
+		It("should stream the progress until the shoot is hibernated", func() {
+			errCh := make(chan error, 1)
+
+			go func() {
+				defer GinkgoRecover()
+				errCh <- run("hibernate", "--wait")
+			}()
+
+			Eventually(out.String).Should(ContainSubstring("Reconcile Succeeded (100%)\n"))
+
+			Eventually(func() string {
+				current := getShoot()
+				current.Status.ObservedGeneration = current.Generation
+				current.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+				current.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+				current.Status.LastOperation.Progress = 30
+				Expect(runtimeClient.Update(context.Background(), current)).To(Succeed())
+
+				return out.String()
+			}).Should(ContainSubstring("Reconcile Processing (30%)\n"))
+
+			updateLastOperation(gardencorev1beta1.LastOperationStateSucceeded, 100)
+
+			Eventually(errCh).Should(Receive(BeNil()))
+			Expect(out.String()).To(HaveSuffix("Reconcile Processing (30%)\nReconcile Succeeded (100%)\nShoot \"myshoot\" is hibernated\n"))
+		})
+
+		It("should fail if the operation failed", func() {
+			errCh := make(chan error, 1)
+
+			go func() {
+				defer GinkgoRecover()
+				errCh <- run("hibernate", "--wait")
+			}()
+
+			Eventually(out.String).Should(ContainSubstring("Successfully requested to hibernate shoot"))
+
+			Eventually(func() error {
+				current := getShoot()
+				current.Status.ObservedGeneration = current.Generation
+				current.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+				current.Status.LastOperation.Progress = 60
+				Expect(runtimeClient.Update(context.Background(), current)).To(Succeed())
+
+				select {
+				case err := <-errCh:
+					return err
+				default:
+					return nil
+				}
+			}).Should(MatchError(`failed to hibernate shoot "myshoot": last operation Reconcile Failed`))
+		})
+
+		It("should fail if the operation does not finish in time", func() {
+			Expect(run("hibernate", "--wait", "--timeout", "100ms")).To(MatchError(`timed out waiting for the last operation of shoot "myshoot" to finish`))
+		})
+	})
+
+	Context("when the shoot has access restrictions", func() {
+		BeforeEach(func() {
+			shootObj.Spec.AccessRestrictions = []gardencorev1beta1.AccessRestrictionWithOptions{{
+				AccessRestriction: gardencorev1beta1.AccessRestriction{Name: "eu-access-only"},
+			}}
+		})
+
+		It("should not hibernate the shoot if the confirmation is declined", func() {
+			fmt.Fprintln(in, "n")
+
+			Expect(run("hibernate")).To(MatchError(target.ErrAborted))
+			Expect(errOut.String()).To(ContainSubstring("Do not access this shoot from outside the EU"))
+			Expect(getShoot().Spec.Hibernation).To(BeNil())
+		})
+
+		It("should hibernate the shoot after confirmation", func() {
+			fmt.Fprintln(in, "y")
+
+			Expect(run("hibernate")).To(Succeed())
+			Expect(getShoot().Spec.Hibernation.Enabled).To(Equal(ptr.To(true)))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package shoot
+
+import (
+	"fmt"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+// withoutWorkersRolloutSuffix is appended to operations that support starting a rotation without rolling out the workers.
+const withoutWorkersRolloutSuffix = "-without-workers-rollout"
+
+// OperationOptions is a struct to support the commands that annotate the shoot with an operation.
+type OperationOptions struct {
+	options
+
+	// Operation is the value of the gardener operation annotation
+	Operation string
+
+	// WithoutWorkersRollout defines if the rotation is started without rolling out the workers
+	WithoutWorkersRollout bool
+
+	// supportsWithoutWorkersRollout is true if the operation can be started without rolling out the workers
+	supportsWithoutWorkersRollout bool
+}
+
+// NewOperationOptions returns initialized OperationOptions.
+func NewOperationOptions(ioStreams util.IOStreams, operation string, supportsWithoutWorkersRollout bool) *OperationOptions {
+	o := &OperationOptions{
+		Operation:                     operation,
+		supportsWithoutWorkersRollout: supportsWithoutWorkersRollout,
+	}
+	o.IOStreams = ioStreams
+
+	return o
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *OperationOptions) AddFlags(flags *pflag.FlagSet) {
+	o.options.AddFlags(flags)
+
+	if o.supportsWithoutWorkersRollout {
+		flags.BoolVar(&o.WithoutWorkersRollout, "without-workers-rollout", o.WithoutWorkersRollout, "Start the rotation without rolling out the worker nodes. The workers have to be rolled out before the rotation can be completed")
+	}
+}
+
+// Run executes the command.
+func (o *OperationOptions) Run(f util.Factory) error {
+	ctx := f.Context()
+
+	shoot, err := o.findShoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	operation := o.Operation
+	if o.WithoutWorkersRollout {
+		operation += withoutWorkersRolloutSuffix
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+
+	shoot.Annotations[v1beta1constants.GardenerOperation] = operation
+
+	if err := o.GardenClient.RuntimeClient().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed to annotate shoot %q with operation %q: %w", shoot.Name, operation, err)
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "Successfully triggered operation %q for shoot %q\n", operation, shoot.Name)
+
+	return nil
+}
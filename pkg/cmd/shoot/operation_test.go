@@ -0,0 +1,172 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package shoot_test
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/ac"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/shoot"
+	"github.com/gardener/gardenctl-v2/pkg/config"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("Shoot Command", func() {
+	const gardenName = "mygarden"
+
+	var (
+		ctrl          *gomock.Controller
+		factory       *utilmocks.MockFactory
+		manager       *targetmocks.MockManager
+		streams       util.IOStreams
+		in            *util.SafeBytesBuffer
+		out           *util.SafeBytesBuffer
+		errOut        *util.SafeBytesBuffer
+		cfg           *config.Config
+		currentTarget target.Target
+		shootObj      *gardencorev1beta1.Shoot
+		runtimeClient client.Client
+		cmd           *cobra.Command
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+
+		cfg = &config.Config{
+			Gardens: []config.Garden{{
+				Name: gardenName,
+				AccessRestrictions: []ac.AccessRestriction{{
+					Key: "eu-access-only",
+					Msg: "Do not access this shoot from outside the EU",
+				}},
+			}},
+		}
+
+		shootObj = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myshoot",
+				Namespace: "garden-prod",
+			},
+		}
+
+		currentTarget = target.NewTarget(gardenName, "prod", "", "myshoot")
+
+		factory.EXPECT().Context().Return(context.Background()).AnyTimes()
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+		factory.EXPECT().TargetFlags().Return(target.NewTargetFlags("", "", "", "", false)).AnyTimes()
+		manager.EXPECT().Configuration().Return(cfg).AnyTimes()
+		manager.EXPECT().CurrentTarget().DoAndReturn(func() (target.Target, error) {
+			return currentTarget, nil
+		}).AnyTimes()
+
+		streams, in, out, errOut = util.NewTestIOStreams()
+	})
+
+	JustBeforeEach(func() {
+		runtimeClient = fake.NewClientWithObjects(
+			&gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-prod")},
+			},
+			shootObj,
+		)
+		manager.EXPECT().GardenClient(gardenName).Return(clientgarden.NewClient(nil, runtimeClient, gardenName), nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	runOperation := func(name string, args ...string) error {
+		cmd = shoot.NewCmdShoot(factory, streams)
+		cmd.SetArgs(append([]string{name}, args...))
+		cmd.SetOut(out)
+		cmd.SetErr(errOut)
+
+		return cmd.Execute()
+	}
+
+	operationAnnotation := func() string {
+		s := &gardencorev1beta1.Shoot{}
+		ExpectWithOffset(1, runtimeClient.Get(context.Background(), client.ObjectKeyFromObject(shootObj), s)).To(Succeed())
+
+		return s.Annotations["gardener.cloud/operation"]
+	}
+
+	DescribeTable("should annotate the targeted shoot with the operation",
+		func(name string, args []string, expected string) {
+			Expect(runOperation(name, args...)).To(Succeed())
+			Expect(operationAnnotation()).To(Equal(expected))
+			Expect(out.String()).To(Equal("Successfully triggered operation \"" + expected + "\" for shoot \"myshoot\"\n"))
+		},
+		Entry("reconcile", "reconcile", nil, "reconcile"),
+		Entry("retry", "retry", nil, "retry"),
+		Entry("maintain", "maintain", nil, "maintain"),
+		Entry("rotate-credentials-start", "rotate-credentials-start", nil, "rotate-credentials-start"),
+		Entry("rotate-credentials-start without workers rollout", "rotate-credentials-start", []string{"--without-workers-rollout"}, "rotate-credentials-start-without-workers-rollout"),
+		Entry("rotate-ca-complete", "rotate-ca-complete", nil, "rotate-ca-complete"),
+		Entry("rotate-ssh-keypair", "rotate-ssh-keypair", nil, "rotate-ssh-keypair"),
+	)
+
+	It("should not support --without-workers-rollout for completing a rotation", func() {
+		Expect(runOperation("rotate-ca-complete", "--without-workers-rollout")).To(MatchError(ContainSubstring("unknown flag: --without-workers-rollout")))
+	})
+
+	It("should fail if no shoot is targeted", func() {
+		currentTarget = target.NewTarget(gardenName, "prod", "", "")
+
+		Expect(runOperation("reconcile")).To(MatchError(ContainSubstring(target.ErrNoShootTargeted.Error())))
+	})
+
+	Context("when the shoot has access restrictions", func() {
+		BeforeEach(func() {
+			shootObj.Spec.AccessRestrictions = []gardencorev1beta1.AccessRestrictionWithOptions{{
+				AccessRestriction: gardencorev1beta1.AccessRestriction{Name: "eu-access-only"},
+			}}
+		})
+
+		It("should annotate the shoot after confirmation", func() {
+			fmt.Fprintln(in, "y")
+
+			Expect(runOperation("reconcile")).To(Succeed())
+			Expect(errOut.String()).To(ContainSubstring("Do not access this shoot from outside the EU"))
+			Expect(errOut.String()).To(ContainSubstring("Do you want to continue? [y/N]: "))
+			Expect(operationAnnotation()).To(Equal("reconcile"))
+		})
+
+		It("should abort if the confirmation is declined", func() {
+			fmt.Fprintln(in, "n")
+
+			Expect(runOperation("reconcile")).To(MatchError(target.ErrAborted))
+			Expect(operationAnnotation()).To(BeEmpty())
+		})
+
+		It("should not ask for confirmation with --confirm-access-restriction", func() {
+			Expect(runOperation("reconcile", "-y")).To(Succeed())
+			Expect(errOut.String()).To(ContainSubstring("Do not access this shoot from outside the EU"))
+			Expect(errOut.String()).NotTo(ContainSubstring("Do you want to continue?"))
+			Expect(operationAnnotation()).To(Equal("reconcile"))
+		})
+	})
+})
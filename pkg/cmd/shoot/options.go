@@ -0,0 +1,103 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/ac"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/config"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// options is a struct with the options shared by all shoot commands.
+type options struct {
+	base.Options
+
+	// ConfirmAccessRestriction, when set to true, implies the user understands the access restrictions for the targeted shoot.
+	ConfirmAccessRestriction bool
+
+	// CurrentTarget holds the current target configuration
+	CurrentTarget target.Target
+
+	// Garden is the configuration of the targeted garden
+	Garden *config.Garden
+
+	// GardenClient is the client for the garden cluster
+	GardenClient clientgarden.Client
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVarP(&o.ConfirmAccessRestriction, "confirm-access-restriction", "y", o.ConfirmAccessRestriction, "Bypasses the need for confirmation of any access restrictions. Set this flag only if you are fully aware of the access restrictions.")
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *options) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		return err
+	}
+
+	if currentTarget.GardenName() == "" {
+		return target.ErrNoGardenTargeted
+	}
+
+	if currentTarget.ShootName() == "" {
+		return target.ErrNoShootTargeted
+	}
+
+	o.CurrentTarget = currentTarget
+
+	cfg := manager.Configuration()
+	if cfg == nil {
+		return errors.New("failed to get configuration")
+	}
+
+	o.Garden, err = cfg.Garden(currentTarget.GardenName())
+	if err != nil {
+		return err
+	}
+
+	o.GardenClient, err = manager.GardenClient(currentTarget.GardenName())
+	if err != nil {
+		return fmt.Errorf("failed to create garden cluster client: %w", err)
+	}
+
+	return nil
+}
+
+// findShoot returns the targeted shoot after the access restrictions of the shoot have been confirmed.
+// As the shoot commands modify the shoot, the confirmation is requested unless ConfirmAccessRestriction is set.
+func (o *options) findShoot(ctx context.Context) (*gardencorev1beta1.Shoot, error) {
+	shoot, err := o.GardenClient.FindShoot(ctx, o.CurrentTarget.AsListOption())
+	if err != nil {
+		return nil, err
+	}
+
+	// do not write access restriction to stdout, otherwise it would break the output format
+	handler := ac.NewAccessRestrictionHandler(o.IOStreams.In, o.IOStreams.ErrOut, !o.ConfirmAccessRestriction)
+	if !handler(ac.CheckAccessRestrictions(o.Garden.AccessRestrictions, shoot)) {
+		return nil, target.ErrAborted
+	}
+
+	return shoot, nil
+}
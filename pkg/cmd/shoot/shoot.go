@@ -0,0 +1,144 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package shoot
+
+import (
+	"fmt"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// operation describes a gardener operation that can be triggered on a shoot.
+type operation struct {
+	// name is the value of the gardener operation annotation, it is also used as command name
+	name string
+	// short is the short description of the command
+	short string
+	// supportsWithoutWorkersRollout is true if the operation can be started without rolling out the workers
+	supportsWithoutWorkersRollout bool
+}
+
+var operations = []operation{
+	{name: v1beta1constants.GardenerOperationReconcile, short: "Trigger a reconciliation of the targeted shoot"},
+	{name: v1beta1constants.ShootOperationRetry, short: "Retry the failed last operation of the targeted shoot"},
+	{name: v1beta1constants.ShootOperationMaintain, short: "Trigger a maintenance of the targeted shoot"},
+	{name: v1beta1constants.OperationRotateCredentialsStart, short: "Start the rotation of all credentials of the targeted shoot", supportsWithoutWorkersRollout: true},
+	{name: v1beta1constants.OperationRotateCredentialsComplete, short: "Complete the rotation of all credentials of the targeted shoot"},
+	{name: v1beta1constants.OperationRotateCAStart, short: "Start the rotation of the certificate authorities of the targeted shoot", supportsWithoutWorkersRollout: true},
+	{name: v1beta1constants.OperationRotateCAComplete, short: "Complete the rotation of the certificate authorities of the targeted shoot"},
+	{name: v1beta1constants.OperationRotateServiceAccountKeyStart, short: "Start the rotation of the service account signing key of the targeted shoot", supportsWithoutWorkersRollout: true},
+	{name: v1beta1constants.OperationRotateServiceAccountKeyComplete, short: "Complete the rotation of the service account signing key of the targeted shoot"},
+	{name: v1beta1constants.OperationRotateETCDEncryptionKeyStart, short: "Start the rotation of the etcd encryption key of the targeted shoot"},
+	{name: v1beta1constants.OperationRotateETCDEncryptionKeyComplete, short: "Complete the rotation of the etcd encryption key of the targeted shoot"},
+	{name: v1beta1constants.OperationRotateObservabilityCredentials, short: "Rotate the observability credentials of the targeted shoot"},
+	{name: v1beta1constants.ShootOperationRotateSSHKeypair, short: "Rotate the ssh keypair of the worker nodes of the targeted shoot"},
+}
+
+// NewCmdShoot returns a new shoot command.
+func NewCmdShoot(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shoot",
+		Short: "Hibernate, wake up or trigger operations on the targeted shoot",
+		Long: `Hibernate, wake up or trigger operations on the targeted shoot.
+Operations are triggered by annotating the shoot with the "gardener.cloud/operation" annotation.
+If the shoot is subject to access restrictions, a confirmation is required before the shoot is modified.`,
+	}
+
+	cmd.AddCommand(newCmdHibernate(f, ioStreams))
+	cmd.AddCommand(newCmdWakeUp(f, ioStreams))
+
+	for _, op := range operations {
+		cmd.AddCommand(newCmdOperation(f, ioStreams, op))
+	}
+
+	return cmd
+}
+
+// newCmdOperation returns a new command that annotates the targeted shoot with the given operation.
+func newCmdOperation(f util.Factory, ioStreams util.IOStreams, op operation) *cobra.Command {
+	o := NewOperationOptions(ioStreams, op.name, op.supportsWithoutWorkersRollout)
+	cmd := &cobra.Command{
+		Use:   op.name,
+		Short: op.short,
+		Long: fmt.Sprintf(`%s.
+The shoot is annotated with "%s=%s".`, op.short, v1beta1constants.GardenerOperation, op.name),
+		Example: fmt.Sprintf(`# trigger operation %[1]q for the targeted shoot
+gardenctl shoot %[1]s
+
+# trigger operation %[1]q for shoot my-shoot in project my-project
+gardenctl shoot %[1]s --project my-project --shoot my-shoot`, op.name),
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddShootFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
+
+// newCmdHibernate returns a new shoot hibernate command.
+func newCmdHibernate(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := NewHibernationOptions(ioStreams, true)
+	cmd := &cobra.Command{
+		Use:   "hibernate",
+		Short: "Hibernate the targeted shoot",
+		Long: `Hibernate the targeted shoot by enabling the hibernation in the shoot specification.
+The worker nodes of a hibernated shoot are removed and the control plane is scaled down.`,
+		Example: `# hibernate the targeted shoot
+gardenctl shoot hibernate
+
+# hibernate shoot my-shoot and wait until it is hibernated
+gardenctl shoot hibernate --project my-project --shoot my-shoot --wait`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddShootFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
+
+// newCmdWakeUp returns a new shoot wake-up command.
+func newCmdWakeUp(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := NewHibernationOptions(ioStreams, false)
+	cmd := &cobra.Command{
+		Use:   "wake-up",
+		Short: "Wake up the targeted shoot from hibernation",
+		Long:  "Wake up the targeted shoot from hibernation by disabling the hibernation in the shoot specification",
+		Example: `# wake up the targeted shoot
+gardenctl shoot wake-up
+
+# wake up the targeted shoot and wait up to 15 minutes until it is awake
+gardenctl shoot wake-up --wait --timeout 15m`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddShootFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
@@ -59,10 +59,61 @@ func userKnownHostsFilesArgument(userKnownHostsFiles []string) *argument {
 	return &argument{value: fmt.Sprintf("-oUserKnownHostsFile=%s", userKnownHostsFilesValue)}
 }
 
+// certificateFileArgument returns the ssh client option to present the SSH certificate of the identity.
+func certificateFileArgument(sshCertificateFile string) argument {
+	return argument{value: fmt.Sprintf("-oCertificateFile=%s", sshCertificateFile)}
+}
+
 func sshCommandArguments(
 	bastionHost string,
 	bastionPort string,
 	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
+	bastionUserKnownHostsFiles []string,
+	bastionStrictHostKeyChecking StrictHostKeyChecking,
+	nodeUserKnownHostsFiles []string,
+	nodeStrictHostKeyChecking StrictHostKeyChecking,
+	nodeHostname string,
+	nodePrivateKeyFiles []PrivateKeyFile,
+	user string,
+	portForwards []PortForward,
+	forwardOnly bool,
+) arguments {
+	args := nodeConnectionArguments(
+		bastionHost,
+		bastionPort,
+		sshPrivateKeyFile,
+		sshCertificateFile,
+		bastionUserKnownHostsFiles,
+		bastionStrictHostKeyChecking,
+		nodeUserKnownHostsFiles,
+		nodeStrictHostKeyChecking,
+		nodePrivateKeyFiles,
+	)
+
+	if len(portForwards) > 0 {
+		// fail instead of opening a session without the requested tunnels
+		args = append(args, argument{value: "-oExitOnForwardFailure=yes", shellEscapeDisabled: true})
+
+		for _, forward := range portForwards {
+			args = append(args, forward.argument())
+		}
+
+		if forwardOnly {
+			args = append(args, argument{value: "-N", shellEscapeDisabled: true})
+		}
+	}
+
+	args = append(args, argument{value: fmt.Sprintf("%s@%s", user, nodeHostname)})
+
+	return arguments{list: args}
+}
+
+func sshExecCommandArguments(
+	bastionHost string,
+	bastionPort string,
+	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
 	bastionUserKnownHostsFiles []string,
 	bastionStrictHostKeyChecking StrictHostKeyChecking,
 	nodeUserKnownHostsFiles []string,
@@ -70,7 +121,134 @@ func sshCommandArguments(
 	nodeHostname string,
 	nodePrivateKeyFiles []PrivateKeyFile,
 	user string,
+	command []string,
+) arguments {
+	args := nodeConnectionArguments(
+		bastionHost,
+		bastionPort,
+		sshPrivateKeyFile,
+		sshCertificateFile,
+		bastionUserKnownHostsFiles,
+		bastionStrictHostKeyChecking,
+		nodeUserKnownHostsFiles,
+		nodeStrictHostKeyChecking,
+		nodePrivateKeyFiles,
+	)
+
+	// never prompt, as the command runs on several nodes in parallel without a terminal
+	args = append(args,
+		argument{value: "-oBatchMode=yes", shellEscapeDisabled: true},
+		argument{value: "-T", shellEscapeDisabled: true},
+		argument{value: fmt.Sprintf("%s@%s", user, nodeHostname)},
+		argument{value: "--", shellEscapeDisabled: true},
+	)
+
+	// ssh joins the words of the command with spaces and the remote shell splits them again,
+	// hence each word is escaped to preserve quoted arguments like "10 min ago"
+	for _, arg := range command {
+		args = append(args, argument{value: util.ShellEscape(arg), shellEscapeDisabled: true})
+	}
+
+	return arguments{list: args}
+}
+
+func scpCommandArguments(
+	bastionHost string,
+	bastionPort string,
+	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
+	bastionUserKnownHostsFiles []string,
+	bastionStrictHostKeyChecking StrictHostKeyChecking,
+	nodeUserKnownHostsFiles []string,
+	nodeStrictHostKeyChecking StrictHostKeyChecking,
+	nodeHostname string,
+	nodePrivateKeyFiles []PrivateKeyFile,
+	user string,
+	recursive bool,
+	operands []scpOperand,
+) arguments {
+	args := nodeConnectionArguments(
+		bastionHost,
+		bastionPort,
+		sshPrivateKeyFile,
+		sshCertificateFile,
+		bastionUserKnownHostsFiles,
+		bastionStrictHostKeyChecking,
+		nodeUserKnownHostsFiles,
+		nodeStrictHostKeyChecking,
+		nodePrivateKeyFiles,
+	)
+
+	if recursive {
+		args = append(args, argument{value: "-r", shellEscapeDisabled: true})
+	}
+
+	// prevent operands starting with a dash from being interpreted as options
+	args = append(args, argument{value: "--", shellEscapeDisabled: true})
+
+	for _, operand := range operands {
+		args = append(args, operand.argument(user, nodeHostname))
+	}
+
+	return arguments{list: args}
+}
+
+// socksProxyArguments returns the ssh client arguments to open a SOCKS5 proxy on the listen address,
+// which forwards the connections through the bastion (like ssh -D).
+func socksProxyArguments(
+	bastionHost string,
+	bastionPort string,
+	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
+	bastionUserKnownHostsFiles []string,
+	bastionStrictHostKeyChecking StrictHostKeyChecking,
+	listenAddress string,
 ) arguments {
+	args := []argument{
+		{value: fmt.Sprintf("-oStrictHostKeyChecking=%s", bastionStrictHostKeyChecking), shellEscapeDisabled: true},
+	}
+
+	if sshPrivateKeyFile != "" {
+		args = append(args, argument{value: "-oIdentitiesOnly=yes", shellEscapeDisabled: true})
+		args = append(args, argument{value: fmt.Sprintf("-i%s", sshPrivateKeyFile)})
+	}
+
+	if sshCertificateFile != "" {
+		args = append(args, certificateFileArgument(sshCertificateFile))
+	}
+
+	if userKnownHostsFilesArg := userKnownHostsFilesArgument(bastionUserKnownHostsFiles); userKnownHostsFilesArg != nil {
+		args = append(args, *userKnownHostsFilesArg)
+	}
+
+	// fail instead of running without the proxy, and do not open a remote shell
+	args = append(args,
+		argument{value: "-oExitOnForwardFailure=yes", shellEscapeDisabled: true},
+		argument{value: "-N", shellEscapeDisabled: true},
+		argument{value: fmt.Sprintf("-D%s", listenAddress)},
+	)
+
+	if bastionPort != "" {
+		args = append(args, argument{value: fmt.Sprintf("-p%s", bastionPort)})
+	}
+
+	args = append(args, argument{value: fmt.Sprintf("%s@%s", SSHBastionUsername, bastionHost)})
+
+	return arguments{list: args}
+}
+
+// nodeConnectionArguments returns the ssh client options to connect to a shoot node using the bastion as proxy.
+func nodeConnectionArguments(
+	bastionHost string,
+	bastionPort string,
+	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
+	bastionUserKnownHostsFiles []string,
+	bastionStrictHostKeyChecking StrictHostKeyChecking,
+	nodeUserKnownHostsFiles []string,
+	nodeStrictHostKeyChecking StrictHostKeyChecking,
+	nodePrivateKeyFiles []PrivateKeyFile,
+) []argument {
 	bastionUserKnownHostsFilesArg := userKnownHostsFilesArgument(bastionUserKnownHostsFiles)
 	nodeUserKnownHostsFilesArg := userKnownHostsFilesArgument(nodeUserKnownHostsFiles)
 
@@ -78,6 +256,7 @@ func sshCommandArguments(
 		bastionHost,
 		bastionPort,
 		sshPrivateKeyFile,
+		sshCertificateFile,
 		bastionUserKnownHostsFilesArg,
 		bastionStrictHostKeyChecking,
 	)
@@ -91,21 +270,27 @@ func sshCommandArguments(
 		args = append(args, *nodeUserKnownHostsFilesArg)
 	}
 
+	// the certificate is presented to the node before the node keys, so that nodes trusting the SSH CA accept it
+	if sshCertificateFile != "" {
+		if sshPrivateKeyFile != "" {
+			args = append(args, argument{value: fmt.Sprintf("-i%s", sshPrivateKeyFile)})
+		}
+
+		args = append(args, certificateFileArgument(sshCertificateFile))
+	}
+
 	for _, file := range nodePrivateKeyFiles {
 		args = append(args, argument{value: fmt.Sprintf("-i%s", file)})
 	}
 
-	args = append(args, argument{value: fmt.Sprintf("-oProxyCommand=%s", proxyCmdArgs.String())})
-
-	args = append(args, argument{value: fmt.Sprintf("%s@%s", user, nodeHostname)})
-
-	return arguments{list: args}
+	return append(args, argument{value: fmt.Sprintf("-oProxyCommand=%s", proxyCmdArgs.String())})
 }
 
 func sshProxyCmdArguments(
 	bastionHost string,
 	bastionPort string,
 	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
 	userKnownHostsFileArg *argument,
 	bastionStrictHostKeyChecking StrictHostKeyChecking,
 ) arguments {
@@ -120,6 +305,10 @@ func sshProxyCmdArguments(
 		args = append(args, argument{value: fmt.Sprintf("-i%s", sshPrivateKeyFile)})
 	}
 
+	if sshCertificateFile != "" {
+		args = append(args, certificateFileArgument(sshCertificateFile))
+	}
+
 	if userKnownHostsFileArg != nil {
 		args = append(args, *userKnownHostsFileArg)
 	}
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package ssh_test
 
 import (
+	"slices"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -19,6 +20,7 @@ type testCase struct {
 	bastionHost                  string
 	bastionPort                  string
 	sshPrivateKeyFile            ssh.PrivateKeyFile
+	sshCertificateFile           string
 	bastionUserKnownHostsFiles   []string
 	bastionStrictHostKeyChecking ssh.StrictHostKeyChecking
 	nodeUserKnownHostsFiles      []string
@@ -27,6 +29,8 @@ type testCase struct {
 	nodePrivateKeyFiles          []ssh.PrivateKeyFile
 	expectedArgs                 []string
 	user                         string
+	portForwards                 []ssh.PortForward
+	forwardOnly                  bool
 }
 
 func newTestCase() testCase {
@@ -52,6 +56,7 @@ var _ = Describe("Arguments", func() {
 					tc.bastionHost,
 					tc.bastionPort,
 					tc.sshPrivateKeyFile,
+					tc.sshCertificateFile,
 					tc.bastionUserKnownHostsFiles,
 					tc.bastionStrictHostKeyChecking,
 					tc.nodeUserKnownHostsFiles,
@@ -59,6 +64,8 @@ var _ = Describe("Arguments", func() {
 					tc.nodeHostname,
 					tc.nodePrivateKeyFiles,
 					tc.user,
+					tc.portForwards,
+					tc.forwardOnly,
 				)
 				res := args.String()
 				exp := strings.Join(tc.expectedArgs, " ")
@@ -127,6 +134,127 @@ var _ = Describe("Arguments", func() {
 				}
 				return tc
 			}()),
+			Entry("SSH certificate", func() testCase {
+				tc := newTestCase()
+				tc.sshCertificateFile = "path/to/cert"
+				tc.expectedArgs = []string{
+					"-oIdentitiesOnly=yes",
+					"-oStrictHostKeyChecking=ask",
+					"'-ipath/to/private/key'",
+					"'-oCertificateFile=path/to/cert'",
+					"'-ipath/to/node/private/key'",
+					`'-oProxyCommand=ssh '"'"'-W[%h]:%p'"'"' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '"'"'-ipath/to/private/key'"'"' '"'"'-oCertificateFile=path/to/cert'"'"' '"'"'gardener@bastion.example.com'"'"' '"'"'-p22'"'"''`,
+					"'gardener@node.example.com'",
+				}
+				return tc
+			}()),
+			Entry("local and remote port forwardings", func() testCase {
+				tc := newTestCase()
+				tc.portForwards = []ssh.PortForward{
+					{Port: 8080, Host: "localhost", HostPort: 10250},
+					{Remote: true, BindAddress: "::1", Port: 9090, Host: "localhost", HostPort: 3000},
+				}
+				tc.expectedArgs = []string{
+					"-oIdentitiesOnly=yes",
+					"-oStrictHostKeyChecking=ask",
+					"'-ipath/to/node/private/key'",
+					`'-oProxyCommand=ssh '"'"'-W[%h]:%p'"'"' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '"'"'-ipath/to/private/key'"'"' '"'"'gardener@bastion.example.com'"'"' '"'"'-p22'"'"''`,
+					"-oExitOnForwardFailure=yes",
+					"'-L8080:localhost:10250'",
+					"'-R[::1]:9090:localhost:3000'",
+					"'gardener@node.example.com'",
+				}
+				return tc
+			}()),
+			Entry("port forwardings without remote shell", func() testCase {
+				tc := newTestCase()
+				tc.portForwards = []ssh.PortForward{
+					{Port: 8080, Host: "localhost", HostPort: 10250},
+				}
+				tc.forwardOnly = true
+				tc.expectedArgs = []string{
+					"-oIdentitiesOnly=yes",
+					"-oStrictHostKeyChecking=ask",
+					"'-ipath/to/node/private/key'",
+					`'-oProxyCommand=ssh '"'"'-W[%h]:%p'"'"' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '"'"'-ipath/to/private/key'"'"' '"'"'gardener@bastion.example.com'"'"' '"'"'-p22'"'"''`,
+					"-oExitOnForwardFailure=yes",
+					"'-L8080:localhost:10250'",
+					"-N",
+					"'gardener@node.example.com'",
+				}
+				return tc
+			}()),
+		)
+	})
+
+	Describe("sshExecCommandArguments", func() {
+		DescribeTable("should escape the words of the command for the remote shell",
+			func(command []string, expectedCommand []string) {
+				tc := newTestCase()
+				args := ssh.SSHExecCommandArguments(
+					tc.bastionHost,
+					tc.bastionPort,
+					tc.sshPrivateKeyFile,
+					tc.sshCertificateFile,
+					tc.bastionUserKnownHostsFiles,
+					tc.bastionStrictHostKeyChecking,
+					tc.nodeUserKnownHostsFiles,
+					tc.nodeStrictHostKeyChecking,
+					"node.example.com",
+					tc.nodePrivateKeyFiles,
+					tc.user,
+					command,
+				)
+
+				values := args.Values()
+				separator := slices.Index(values, "--")
+				Expect(values[separator-3 : separator]).To(Equal([]string{"-oBatchMode=yes", "-T", "gardener@node.example.com"}))
+				Expect(values[separator+1:]).To(Equal(expectedCommand))
+				Expect(args.String()).To(HaveSuffix(" -- " + strings.Join(expectedCommand, " ")))
+			},
+			Entry("simple command", []string{"uptime"}, []string{"'uptime'"}),
+			Entry("quoted argument", []string{"journalctl", "--since", "10 min ago"}, []string{"'journalctl'", "'--since'", "'10 min ago'"}),
+			Entry("shell script", []string{"sh", "-c", "echo 'a'; b"}, []string{"'sh'", "'-c'", `'echo '"'"'a'"'"'; b'`}),
+		)
+	})
+
+	Describe("scpCommandArguments", func() {
+		DescribeTable("should match the expected arguments as string",
+			func(recursive bool, nodeHostname string, operands []string, expectedOperands []string) {
+				tc := newTestCase()
+				args := ssh.SCPCommandArguments(
+					tc.bastionHost,
+					tc.bastionPort,
+					tc.sshPrivateKeyFile,
+					tc.sshCertificateFile,
+					tc.bastionUserKnownHostsFiles,
+					tc.bastionStrictHostKeyChecking,
+					tc.nodeUserKnownHostsFiles,
+					tc.nodeStrictHostKeyChecking,
+					nodeHostname,
+					tc.nodePrivateKeyFiles,
+					tc.user,
+					recursive,
+					operands,
+				)
+
+				exp := append([]string{
+					"-oIdentitiesOnly=yes",
+					"-oStrictHostKeyChecking=ask",
+					"'-ipath/to/node/private/key'",
+					`'-oProxyCommand=ssh '"'"'-W[%h]:%p'"'"' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '"'"'-ipath/to/private/key'"'"' '"'"'gardener@bastion.example.com'"'"' '"'"'-p22'"'"''`,
+				}, expectedOperands...)
+				Expect(args.String()).To(Equal(strings.Join(exp, " ")))
+			},
+			Entry("copy from the node", false, "node.example.com",
+				[]string{"node-1:/var/log/kubelet.log", "."},
+				[]string{"--", "'gardener@node.example.com:/var/log/kubelet.log'", "'.'"}),
+			Entry("recursive copy to the node", true, "node.example.com",
+				[]string{"./dir", "other/file:with-colon", "node-1:"},
+				[]string{"-r", "--", "'./dir'", "'other/file:with-colon'", "'gardener@node.example.com:'"}),
+			Entry("IPv6 node address", false, "fd00::1",
+				[]string{"node-1:file", "/tmp"},
+				[]string{"--", "'gardener@[fd00::1]:file'", "'/tmp'"}),
 		)
 	})
 })
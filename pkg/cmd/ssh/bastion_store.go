@@ -0,0 +1,178 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// bastionStoreDirectoryName is the name of the directory below the session directory that contains the bastion store.
+	bastionStoreDirectoryName = "bastions"
+	// bastionStorePublicKeyFileName is the name of the file containing the public SSH key of a stored bastion.
+	bastionStorePublicKeyFileName = "id.pub"
+	// bastionStorePrivateKeyFileName is the name of the file containing the generated private SSH key of a stored bastion.
+	bastionStorePrivateKeyFileName = "id"
+	// bastionStoreLeasesDirectoryName is the name of the directory containing the leases of a stored bastion.
+	bastionStoreLeasesDirectoryName = "leases"
+)
+
+// bastionStore keeps track of the bastions created within a gardenctl session, so that later commands of
+// the same session can reuse them. For each bastion, it stores the public SSH key, the private SSH key if it
+// has been generated by gardenctl, and a lease for every command that is currently using the bastion.
+type bastionStore struct {
+	// dir is the directory of the store
+	dir string
+}
+
+// bastionLease marks a bastion as used by the current command. A lease is renewed while the command is running
+// and considered expired if it has not been renewed for some time, e.g. because the command has been killed.
+type bastionLease struct {
+	// path is the path of the lease file
+	path string
+}
+
+// newBastionStore returns a bastion store within the given session directory.
+func newBastionStore(sessionDir string) *bastionStore {
+	return &bastionStore{dir: filepath.Join(sessionDir, bastionStoreDirectoryName)}
+}
+
+// entryDir returns the directory of the stored bastion with the given key.
+func (s *bastionStore) entryDir(key client.ObjectKey) string {
+	return filepath.Join(s.dir, key.Namespace, key.Name)
+}
+
+// save stores the public SSH key of a bastion. If privateKeyFile is not empty, the private SSH key is copied
+// into the store. The paths of the stored keys are returned, the private key path is empty if it has not been stored.
+func (s *bastionStore) save(key client.ObjectKey, publicKey []byte, privateKeyFile PrivateKeyFile) (PrivateKeyFile, PublicKeyFile, error) {
+	dir := s.entryDir(key)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", "", fmt.Errorf("failed to create bastion store directory: %w", err)
+	}
+
+	storedPublicKeyFile := PublicKeyFile(filepath.Join(dir, bastionStorePublicKeyFileName))
+	if err := writeKeyFile(storedPublicKeyFile.String(), publicKey); err != nil {
+		return "", "", err
+	}
+
+	if privateKeyFile == "" {
+		return "", storedPublicKeyFile, nil
+	}
+
+	privateKey, err := os.ReadFile(privateKeyFile.String())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read private SSH key: %w", err)
+	}
+
+	storedPrivateKeyFile := PrivateKeyFile(filepath.Join(dir, bastionStorePrivateKeyFileName))
+	if err := writeKeyFile(storedPrivateKeyFile.String(), privateKey); err != nil {
+		return "", "", err
+	}
+
+	return storedPrivateKeyFile, storedPublicKeyFile, nil
+}
+
+// load returns the stored public SSH key of a bastion and the path of its stored private SSH key, which is empty if
+// the private key has not been stored. An error wrapping fs.ErrNotExist is returned if the bastion is not stored.
+func (s *bastionStore) load(key client.ObjectKey) ([]byte, PrivateKeyFile, PublicKeyFile, error) {
+	dir := s.entryDir(key)
+	publicKeyFile := PublicKeyFile(filepath.Join(dir, bastionStorePublicKeyFileName))
+
+	publicKey, err := os.ReadFile(publicKeyFile.String())
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	privateKeyFile := PrivateKeyFile(filepath.Join(dir, bastionStorePrivateKeyFileName))
+	if _, err := os.Stat(privateKeyFile.String()); errors.Is(err, fs.ErrNotExist) {
+		privateKeyFile = ""
+	} else if err != nil {
+		return nil, "", "", err
+	}
+
+	return publicKey, privateKeyFile, publicKeyFile, nil
+}
+
+// remove removes the bastion from the store.
+func (s *bastionStore) remove(key client.ObjectKey) error {
+	if err := os.RemoveAll(s.entryDir(key)); err != nil {
+		return fmt.Errorf("failed to remove bastion from store: %w", err)
+	}
+
+	return nil
+}
+
+// acquire creates a new lease for the bastion.
+func (s *bastionStore) acquire(key client.ObjectKey) (*bastionLease, error) {
+	dir := filepath.Join(s.entryDir(key), bastionStoreLeasesDirectoryName)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create bastion lease directory: %w", err)
+	}
+
+	id, err := utils.GenerateRandomString(8)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bastion lease name: %w", err)
+	}
+
+	lease := &bastionLease{path: filepath.Join(dir, strings.ToLower(id))}
+	if err := os.WriteFile(lease.path, nil, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to create bastion lease: %w", err)
+	}
+
+	return lease, nil
+}
+
+// renew updates the modification time of the lease.
+func (l *bastionLease) renew(now time.Time) error {
+	return os.Chtimes(l.path, now, now)
+}
+
+// release removes the lease and returns the number of other leases of the bastion that have been renewed within ttl.
+// Expired leases of other commands are removed as well.
+func (l *bastionLease) release(now time.Time, ttl time.Duration) (int, error) {
+	if err := os.Remove(l.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, fmt.Errorf("failed to release bastion lease: %w", err)
+	}
+
+	dir := filepath.Dir(l.path)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("failed to read bastion leases: %w", err)
+	}
+
+	active := 0
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue // removed in the meantime
+		}
+
+		if now.Sub(info.ModTime()) > ttl {
+			_ = os.Remove(filepath.Join(dir, entry.Name()))
+			continue
+		}
+
+		active++
+	}
+
+	return active, nil
+}
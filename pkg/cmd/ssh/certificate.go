@@ -0,0 +1,228 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/crypto/ssh"
+	"k8s.io/klog/v2"
+
+	"github.com/gardener/gardenctl-v2/pkg/config"
+)
+
+const (
+	// sshCertificatePrincipalsEnv is the environment variable that passes the comma separated principals to the signer command.
+	sshCertificatePrincipalsEnv = "GARDENCTL_SSH_CERTIFICATE_PRINCIPALS"
+	// sshCertificateTTLEnv is the environment variable that passes the validity period to the signer command.
+	sshCertificateTTLEnv = "GARDENCTL_SSH_CERTIFICATE_TTL"
+	// sshCertificateClockSkew is subtracted from the start of the validity period to tolerate clocks that are behind.
+	sshCertificateClockSkew = time.Minute
+)
+
+// sshCertificateConfig returns the SSH certificate authority configuration of the given garden,
+// or nil if the public SSH keys are not signed.
+func sshCertificateConfig(cfg *config.Config, gardenName string) (*config.SSHCertificateConfig, error) {
+	garden, err := cfg.Garden(gardenName)
+	if err != nil {
+		return nil, err
+	}
+
+	if garden.SSHCertificate == nil {
+		return nil, nil
+	}
+
+	if err := garden.SSHCertificate.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sshCertificate configuration of garden %q: %w", gardenName, err)
+	}
+
+	return garden.SSHCertificate, nil
+}
+
+// signSSHPublicKey signs the public SSH key with the configured SSH certificate authority and returns the
+// certificate in the authorized_keys format.
+func signSSHPublicKey(ctx context.Context, certConfig *config.SSHCertificateConfig, publicKey []byte, principals []string) ([]byte, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH public key: %w", err)
+	}
+
+	var signed []byte
+
+	if len(certConfig.SignerCommand) > 0 {
+		signed, err = runSSHCertificateSigner(ctx, certConfig.SignerCommand, publicKey, principals, certConfig.CertificateTTL())
+	} else {
+		signed, err = signWithCAKeyFile(certConfig.CAKeyFile, key, principals, certConfig.CertificateTTL())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey(signed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH certificate: %w", err)
+	}
+
+	certificate, ok := parsed.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("expected an SSH certificate, but got a public key of type %s", parsed.Type())
+	}
+
+	if !bytes.Equal(certificate.Key.Marshal(), key.Marshal()) {
+		return nil, errors.New("the SSH certificate has not been issued for the public SSH key")
+	}
+
+	return ssh.MarshalAuthorizedKey(certificate), nil
+}
+
+// runSSHCertificateSigner runs the external signer command, which reads the public SSH key from stdin and
+// prints the certificate to stdout.
+func runSSHCertificateSigner(ctx context.Context, command []string, publicKey []byte, principals []string, ttl time.Duration) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) //nolint:gosec // the signer command is configured by the user
+	cmd.Stdin = bytes.NewReader(publicKey)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", sshCertificatePrincipalsEnv, strings.Join(principals, ",")),
+		fmt.Sprintf("%s=%s", sshCertificateTTLEnv, ttl),
+	)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("SSH certificate signer command %q failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return out, nil
+}
+
+// signWithCAKeyFile issues a user certificate for the public SSH key, which is signed with the private key of the
+// SSH certificate authority and valid for the given principals and TTL.
+func signWithCAKeyFile(caKeyFile string, key ssh.PublicKey, principals []string, ttl time.Duration) ([]byte, error) {
+	path, err := homedir.Expand(caKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	caKey, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH CA key: %w", err)
+	}
+
+	authority, err := ssh.ParsePrivateKey(caKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH CA key %q: %w", caKeyFile, err)
+	}
+
+	var serial [8]byte
+	if _, err := rand.Read(serial[:]); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	certificate := &ssh.Certificate{
+		Key:             key,
+		Serial:          binary.BigEndian.Uint64(serial[:]),
+		CertType:        ssh.UserCert,
+		KeyId:           "gardenctl",
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-sshCertificateClockSkew).Unix()), //nolint:gosec // the timestamp is positive
+		ValidBefore:     uint64(now.Add(ttl).Unix()),                      //nolint:gosec // the timestamp is positive
+		Permissions: ssh.Permissions{
+			Extensions: map[string]string{
+				"permit-pty":             "",
+				"permit-port-forwarding": "",
+			},
+		},
+	}
+
+	if err := certificate.SignCert(rand.Reader, authority); err != nil {
+		return nil, fmt.Errorf("failed to sign SSH certificate: %w", err)
+	}
+
+	return ssh.MarshalAuthorizedKey(certificate), nil
+}
+
+// createSSHCertificate signs the public SSH key if an SSH certificate authority is configured for the garden and
+// writes the certificate to a temporary file, which is removed during the cleanup.
+func (o *SSHOptions) createSSHCertificate(ctx context.Context, cfg *config.Config, gardenName string, sshPublicKey []byte) error {
+	certConfig, err := sshCertificateConfig(cfg, gardenName)
+	if err != nil || certConfig == nil {
+		return err
+	}
+
+	principals := certConfig.Principals
+	if len(principals) == 0 {
+		principals = []string{SSHBastionUsername}
+		if o.User != SSHBastionUsername {
+			principals = append(principals, o.User)
+		}
+	}
+
+	certificate, err := signSSHPublicKey(ctx, certConfig, sshPublicKey, principals)
+	if err != nil {
+		return fmt.Errorf("failed to sign SSH public key: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "gctlv2-cert-*.pub")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	o.SSHCertificateFile = file.Name()
+
+	if _, err := file.Write(certificate); err != nil {
+		return fmt.Errorf("failed to write SSH certificate: %w", err)
+	}
+
+	klog.FromContext(ctx).Info("Signed the public SSH key", "certificateFile", o.SSHCertificateFile, "principals", principals, "ttl", certConfig.CertificateTTL())
+
+	return nil
+}
+
+// newCertSigner returns a signer that presents the SSH certificate for the private key, and the signer of the private key.
+func newCertSigner(certificateFile string, privateKey []byte) (ssh.Signer, ssh.Signer, error) {
+	content, err := os.ReadFile(certificateFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read SSH certificate from %q: %w", certificateFile, err)
+	}
+
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SSH certificate %q: %w", certificateFile, err)
+	}
+
+	certificate, ok := parsed.(*ssh.Certificate)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid SSH certificate %q: not a certificate", certificateFile)
+	}
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid private SSH key: %w", err)
+	}
+
+	certSigner, err := ssh.NewCertSigner(certificate, signer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SSH certificate %q: %w", certificateFile, err)
+	}
+
+	return certSigner, signer, nil
+}
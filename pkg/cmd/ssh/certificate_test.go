@@ -0,0 +1,130 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardenctl-v2/pkg/config"
+)
+
+var _ = Describe("signSSHPublicKey", func() {
+	var (
+		tempDir   string
+		caKeyFile string
+		authority ssh.Signer
+		publicKey []byte
+	)
+
+	BeforeEach(func() {
+		tempDir = GinkgoT().TempDir()
+
+		_, caPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		authority, err = ssh.NewSignerFromKey(caPrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		block, err := ssh.MarshalPrivateKey(caPrivateKey, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		caKeyFile = filepath.Join(tempDir, "ca")
+		Expect(writeKeyFile(caKeyFile, pem.EncodeToMemory(block))).To(Succeed())
+
+		_, publicKeyFile, err := createSSHKeypair(tempDir, "key", SSHKeyTypeED25519)
+		Expect(err).NotTo(HaveOccurred())
+
+		publicKey, err = os.ReadFile(publicKeyFile.String())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	parseCertificate := func(data []byte) *ssh.Certificate {
+		parsed, _, _, _, err := ssh.ParseAuthorizedKey(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(BeAssignableToTypeOf(&ssh.Certificate{}))
+
+		return parsed.(*ssh.Certificate)
+	}
+
+	It("should issue a user certificate with the CA key file", func() {
+		certConfig := &config.SSHCertificateConfig{
+			CAKeyFile: caKeyFile,
+			TTL:       &metav1.Duration{Duration: 10 * time.Minute},
+		}
+
+		data, err := signSSHPublicKey(context.Background(), certConfig, publicKey, []string{"gardener", "admin"})
+		Expect(err).NotTo(HaveOccurred())
+
+		certificate := parseCertificate(data)
+		Expect(certificate.CertType).To(Equal(uint32(ssh.UserCert)))
+		Expect(certificate.ValidPrincipals).To(Equal([]string{"gardener", "admin"}))
+		Expect(time.Unix(int64(certificate.ValidBefore), 0)).To(BeTemporally("~", time.Now().Add(10*time.Minute), 5*time.Second)) //nolint:gosec // the timestamp is positive
+		Expect(certificate.Permissions.Extensions).To(HaveKey("permit-pty"))
+
+		checker := &ssh.CertChecker{
+			IsUserAuthority: func(auth ssh.PublicKey) bool {
+				return string(auth.Marshal()) == string(authority.PublicKey().Marshal())
+			},
+		}
+		Expect(checker.CheckCert("gardener", certificate)).To(Succeed())
+	})
+
+	It("should run the signer command with the principals and the TTL", func() {
+		issued, err := signWithCAKeyFile(caKeyFile, parseAuthorizedKey(publicKey), []string{"gardener"}, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		certificateFile := filepath.Join(tempDir, "cert")
+		Expect(os.WriteFile(certificateFile, issued, 0o600)).To(Succeed())
+
+		certConfig := &config.SSHCertificateConfig{
+			SignerCommand: []string{"sh", "-c", `cat > "$1/stdin" && echo "$GARDENCTL_SSH_CERTIFICATE_PRINCIPALS $GARDENCTL_SSH_CERTIFICATE_TTL" > "$1/env" && cat "$1/cert"`, "signer", tempDir},
+		}
+
+		data, err := signSSHPublicKey(context.Background(), certConfig, publicKey, []string{"gardener", "admin"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseCertificate(data).ValidPrincipals).To(Equal([]string{"gardener"}))
+
+		Expect(os.ReadFile(filepath.Join(tempDir, "stdin"))).To(Equal(publicKey))
+		Expect(os.ReadFile(filepath.Join(tempDir, "env"))).To(Equal([]byte("gardener,admin 1h0m0s\n")))
+	})
+
+	It("should reject a signer command that does not return a certificate", func() {
+		certConfig := &config.SSHCertificateConfig{
+			SignerCommand: []string{"cat"},
+		}
+
+		_, err := signSSHPublicKey(context.Background(), certConfig, publicKey, []string{"gardener"})
+		Expect(err).To(MatchError(ContainSubstring("expected an SSH certificate")))
+	})
+
+	It("should report the output of a failing signer command", func() {
+		certConfig := &config.SSHCertificateConfig{
+			SignerCommand: []string{"sh", "-c", "echo access denied >&2; exit 1"},
+		}
+
+		_, err := signSSHPublicKey(context.Background(), certConfig, publicKey, []string{"gardener"})
+		Expect(err).To(MatchError(ContainSubstring("access denied")))
+	})
+})
+
+func parseAuthorizedKey(data []byte) ssh.PublicKey {
+	key, _, _, _, err := ssh.ParseAuthorizedKey(data)
+	Expect(err).NotTo(HaveOccurred())
+
+	return key
+}
@@ -41,6 +41,9 @@ type ConnectInformation struct {
 
 	// NodeStrictHostKeyChecking controls the SSH strict host key checking behavior for the shoot node.
 	NodeStrictHostKeyChecking StrictHostKeyChecking `json:"nodeStrictHostKeyChecking"`
+
+	// SSHConfigFile is the path of the generated ssh_config file, empty if no ssh_config file has been generated.
+	SSHConfigFile string `json:"sshConfigFile,omitempty"`
 }
 
 var _ fmt.Stringer = &ConnectInformation{}
@@ -61,6 +64,8 @@ type Bastion struct {
 	SSHPublicKeyFile PublicKeyFile `json:"publicKeyFile"`
 	// SSHPrivateKeyFile is the full path to the file containing the private SSH key.
 	SSHPrivateKeyFile PrivateKeyFile `json:"privateKeyFile"`
+	// SSHCertificateFile is the full path to the file containing the SSH certificate of the public SSH key, if it has been signed.
+	SSHCertificateFile string `json:"certificateFile,omitempty"`
 	// UserKnownHostsFiles is a list of custom known hosts files for the SSH connection to the bastion.
 	UserKnownHostsFiles []string `json:"userKnownHostsFiles"`
 	// StrictHostKeyChecking controls the StrictHostKeyChecking option for the SSH connection to the bastion.
@@ -96,6 +101,7 @@ func NewConnectInformation(
 	nodeHostname string,
 	sshPublicKeyFile PublicKeyFile,
 	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
 	nodePrivateKeyFiles []PrivateKeyFile,
 	nodes []corev1.Node,
 	pendingNodeNames []string,
@@ -164,6 +170,7 @@ func NewConnectInformation(
 			},
 			SSHPublicKeyFile:      sshPublicKeyFile,
 			SSHPrivateKeyFile:     sshPrivateKeyFile,
+			SSHCertificateFile:    sshCertificateFile,
 			UserKnownHostsFiles:   bastionUserKnownHostsFiles,
 			StrictHostKeyChecking: bastionStrictHostKeyChecking,
 		},
@@ -230,6 +237,7 @@ func (p *ConnectInformation) String() string {
 		p.Bastion.PreferredAddress,
 		p.Bastion.Port,
 		p.Bastion.SSHPrivateKeyFile,
+		p.Bastion.SSHCertificateFile,
 		p.Bastion.UserKnownHostsFiles,
 		p.Bastion.StrictHostKeyChecking,
 		p.NodeUserKnownHostsFiles,
@@ -237,6 +245,8 @@ func (p *ConnectInformation) String() string {
 		nodeHostname,
 		p.NodePrivateKeyFiles,
 		p.User,
+		nil,
+		false,
 	)
 
 	fmt.Fprintf(&buf, "> Connect to shoot nodes by using the bastion as a proxy/jump host.\n")
@@ -248,6 +258,11 @@ func (p *ConnectInformation) String() string {
 
 	fmt.Fprintf(&buf, "ssh %s\n\n", connectArgs.String())
 
+	if p.SSHConfigFile != "" {
+		fmt.Fprintf(&buf, "> Alternatively, add the following line to the top of your ~/.ssh/config and connect with \"ssh NODE_NAME\":\n\n")
+		fmt.Fprintf(&buf, "Include %s\n\n", sshConfigValue(p.SSHConfigFile))
+	}
+
 	return buf.String()
 }
 
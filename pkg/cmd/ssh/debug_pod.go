@@ -0,0 +1,310 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+const (
+	// debugPodContainerName is the name of the container of the debug pod.
+	debugPodContainerName = "debug"
+	// debugPodHostRootPath is the path at which the root filesystem of the node is mounted into the debug pod.
+	debugPodHostRootPath = "/host"
+	// debugPodManagedByLabel marks the debug pods created by gardenctl.
+	debugPodManagedByLabel = "app.kubernetes.io/managed-by"
+)
+
+var (
+	// pollDebugPodStatusInterval is the interval in which the status of the debug pod is checked.
+	pollDebugPodStatusInterval = 2 * time.Second
+
+	// attachToPod attaches the IO streams to the container of the debug pod until the shell exits.
+	attachToPod = func(ctx context.Context, clientConfig clientcmd.ClientConfig, pod *corev1.Pod, ioStreams util.IOStreams) error {
+		restConfig, err := clientConfig.ClientConfig()
+		if err != nil {
+			return err
+		}
+
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return err
+		}
+
+		in, tty := ioStreams.In.(*os.File)
+		tty = tty && term.IsTerminal(int(in.Fd()))
+
+		attachOptions := &corev1.PodAttachOptions{
+			Container: debugPodContainerName,
+			Stdin:     true,
+			Stdout:    true,
+			// the output of stderr is merged into stdout by the pseudo terminal
+			Stderr: !tty,
+			TTY:    tty,
+		}
+
+		req := clientset.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(pod.Namespace).
+			Name(pod.Name).
+			SubResource("attach").
+			VersionedParams(attachOptions, scheme.ParameterCodec)
+
+		executor, err := remotecommand.NewSPDYExecutor(restConfig, "POST", req.URL())
+		if err != nil {
+			return fmt.Errorf("failed to attach to debug pod: %w", err)
+		}
+
+		streamOptions := remotecommand.StreamOptions{
+			Stdin:  ioStreams.In,
+			Stdout: ioStreams.Out,
+			Tty:    tty,
+		}
+
+		if tty {
+			state, err := term.MakeRaw(int(in.Fd()))
+			if err != nil {
+				return fmt.Errorf("failed to switch terminal to raw mode: %w", err)
+			}
+			defer func() { _ = term.Restore(int(in.Fd()), state) }()
+
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			streamOptions.TerminalSizeQueue = newTerminalSizeQueue(ctx, in)
+		} else {
+			streamOptions.Stderr = ioStreams.ErrOut
+		}
+
+		return executor.StreamWithContext(ctx, streamOptions)
+	}
+)
+
+// terminalSizeQueue propagates size changes of the local terminal to the pseudo terminal of the debug pod.
+type terminalSizeQueue struct {
+	sizes chan remotecommand.TerminalSize
+}
+
+var _ remotecommand.TerminalSizeQueue = &terminalSizeQueue{}
+
+// newTerminalSizeQueue returns a queue that yields the current size of the terminal and every change of it until
+// the context is cancelled.
+func newTerminalSizeQueue(ctx context.Context, terminal *os.File) *terminalSizeQueue {
+	q := &terminalSizeQueue{sizes: make(chan remotecommand.TerminalSize, 1)}
+
+	go func() {
+		defer close(q.sizes)
+
+		ticker := time.NewTicker(terminalSizePollInterval)
+		defer ticker.Stop()
+
+		var width, height int
+
+		for {
+			if w, h, err := term.GetSize(int(terminal.Fd())); err == nil && (w != width || h != height) {
+				width, height = w, h
+
+				select {
+				case q.sizes <- remotecommand.TerminalSize{Width: uint16(width), Height: uint16(height)}: //nolint:gosec // terminal sizes fit into uint16
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return q
+}
+
+// Next returns the next size of the terminal, or nil once the session has ended.
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q.sizes
+	if !ok {
+		return nil
+	}
+
+	return &size
+}
+
+// runWithDebugPod opens an interactive shell on the node by attaching to a privileged pod, which is scheduled on
+// the node with the shoot cluster client and deleted afterwards. No bastion is created.
+func (o *SSHOptions) runWithDebugPod(f util.Factory) error {
+	ctx := f.Context()
+	logger := klog.FromContext(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ts, err := o.resolveTargetedShoot(ctx, f)
+	if err != nil || ts == nil {
+		return err
+	}
+
+	shootClient, err := ts.manager.ShootClient(ctx, ts.target)
+	if err != nil {
+		return err
+	}
+
+	if err := o.selectNode(ctx, shootClient); err != nil {
+		return err
+	}
+
+	// unlike for the bastion, the node must exist, as the pod is scheduled on it
+	if _, err := getShootNode(ctx, o, shootClient); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("node %q not found in the Shoot cluster", o.NodeName)
+		}
+
+		return fmt.Errorf("failed to get node: %w", err)
+	}
+
+	clientConfig, err := ts.manager.ClientConfig(ctx, ts.target)
+	if err != nil {
+		return err
+	}
+
+	// allow to cancel at any time, but with us still deleting the pod
+	signalChan := createSignalChannel()
+
+	go func() {
+		<-signalChan
+
+		logger.Info("Caught signal, cancelling...")
+		cancel()
+	}()
+
+	pod := newDebugPod(o.DebugPodNamespace, o.NodeName, o.DebugPodImage)
+
+	logger.Info("Creating debug pod", "node", o.NodeName, "namespace", o.DebugPodNamespace)
+
+	if err := shootClient.Create(ctx, pod); err != nil {
+		return fmt.Errorf("failed to create debug pod: %w", err)
+	}
+
+	// do not use `ctx`, as it might be cancelled already when deleting the pod
+	defer deleteDebugPod(f.Context(), shootClient, pod)
+
+	logger.Info("Waiting for debug pod to be running…", "pod", klog.KObj(pod), "waitTimeout", o.WaitTimeout)
+
+	if err := waitForDebugPod(ctx, shootClient, pod, o.WaitTimeout); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "> Attaching to debug pod %s on node %s. If you don't see a command prompt, try pressing enter.\n", klog.KObj(pod), o.NodeName)
+
+	return attachToPod(ctx, clientConfig, pod, o.IOStreams)
+}
+
+// newDebugPod returns a privileged pod for the given node, which shares the process, network and IPC namespaces
+// of the node and starts a login shell in the root filesystem of the node.
+func newDebugPod(namespace, nodeName, image string) *corev1.Pod {
+	hostRootVolume := "host-root"
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "gardenctl-debug-",
+			Namespace:    namespace,
+			Labels: map[string]string{
+				debugPodManagedByLabel: "gardenctl",
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      nodeName,
+			HostPID:       true,
+			HostNetwork:   true,
+			HostIPC:       true,
+			RestartPolicy: corev1.RestartPolicyNever,
+			// the pod must be scheduled on the node regardless of its taints
+			Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:  debugPodContainerName,
+				Image: image,
+				Command: []string{
+					"chroot", debugPodHostRootPath,
+					"/bin/sh", "-c", "if command -v bash >/dev/null 2>&1; then exec bash -l; else exec sh -l; fi",
+				},
+				Stdin:     true,
+				StdinOnce: true,
+				TTY:       true,
+				SecurityContext: &corev1.SecurityContext{
+					Privileged: ptr.To(true),
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      hostRootVolume,
+					MountPath: debugPodHostRootPath,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: hostRootVolume,
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+				},
+			}},
+		},
+	}
+}
+
+// waitForDebugPod waits until the container of the debug pod is running.
+func waitForDebugPod(ctx context.Context, shootClient client.Client, pod *corev1.Pod, timeout time.Duration) error {
+	err := wait.PollUntilContextTimeout(ctx, pollDebugPodStatusInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := shootClient.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+			return false, err
+		}
+
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodSucceeded, corev1.PodFailed:
+			return false, fmt.Errorf("debug pod terminated with phase %s", pod.Status.Phase)
+		default:
+			return false, nil
+		}
+	})
+	if wait.Interrupted(err) {
+		return errors.New("timed out waiting for the debug pod to be running")
+	} else if err != nil {
+		return fmt.Errorf("an error occurred while waiting for the debug pod to be running: %w", err)
+	}
+
+	return nil
+}
+
+// deleteDebugPod deletes the debug pod without waiting for its graceful termination.
+func deleteDebugPod(ctx context.Context, shootClient client.Client, pod *corev1.Pod) {
+	logger := klog.FromContext(ctx)
+
+	logger.Info("Deleting debug pod", "pod", klog.KObj(pod))
+
+	if err := client.IgnoreNotFound(shootClient.Delete(ctx, pod, client.GracePeriodSeconds(0))); err != nil {
+		logger.Error(err, "Failed to delete debug pod", "pod", klog.KObj(pod))
+	}
+}
@@ -0,0 +1,328 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog/v2"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// NewCmdSSHExec returns a new ssh exec command.
+func NewCmdSSHExec(f util.Factory, o *SSHExecOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [flags] -- COMMAND [ARGS...]",
+		Short: "Run a command on several nodes of a Shoot cluster in parallel",
+		Long: `Run a command on several nodes of a Shoot cluster in parallel.
+
+A single bastion is created to access the nodes and is automatically cleaned up afterwards.
+The nodes can be selected by name, worker pool, zone or label selector. If no selection is given, the command runs on all nodes.
+
+The output of the command is prefixed with the name of the node. With --output json or yaml, the output and exit code
+of the command are collected per node instead. As the command runs without a terminal, the host keys of the nodes are
+accepted on first use by default.`,
+		Example: `# Show the kubelet logs of the last 10 minutes on all nodes
+gardenctl ssh exec -- journalctl -u kubelet --since 10m
+
+# Check the disk usage on the nodes of a worker pool, five nodes at a time
+gardenctl ssh exec --pool worker-1 --concurrency 5 -- df -h
+
+# Collect the kernel version of specific nodes in JSON format
+gardenctl ssh exec --node my-shoot-node-1 --node my-shoot-node-2 -o json -- uname -r`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: base.WrapRunE(o, f),
+	}
+
+	// all arguments after the first positional argument belong to the command
+	cmd.Flags().SetInterspersed(false)
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+	o.RegisterCompletionFuncsForStrictHostKeyCheckings(cmd)
+	registerCompletionFuncForKeyType(cmd)
+	utilruntime.Must(cmd.RegisterFlagCompletionFunc("node", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nodeNameCompletions(f, toComplete, ""), cobra.ShellCompDirectiveNoFileComp
+	}))
+
+	o.AccessConfig.AddFlags(cmd.Flags())
+	RegisterCompletionFuncsForAccessConfigFlags(cmd, f)
+
+	f.TargetFlags().AddFlags(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, o.IOStreams, cmd.Flags())
+
+	return cmd
+}
+
+// SSHExecOptions contains all the configurable options for the ssh exec command.
+type SSHExecOptions struct {
+	SSHOptions
+
+	// Concurrency is the maximum number of nodes the command runs on at the same time
+	Concurrency int
+
+	// Command is the command and its arguments to run on the nodes
+	Command []string
+}
+
+// ExecResult is the result of running the command on a node.
+type ExecResult struct {
+	// Node is the name of the node
+	Node string `json:"node"`
+	// Hostname is the address used to connect to the node
+	Hostname string `json:"hostname"`
+	// ExitCode is the exit code of the ssh client, which is the exit code of the command or 255 if the connection failed
+	ExitCode int `json:"exitCode"`
+	// Stdout is the standard output of the command
+	Stdout string `json:"stdout"`
+	// Stderr is the standard error output of the command
+	Stderr string `json:"stderr"`
+	// Error is set if the ssh client could not be executed
+	Error string `json:"error,omitempty"`
+}
+
+// NewSSHExecOptions returns initialized SSHExecOptions.
+func NewSSHExecOptions(ioStreams util.IOStreams) *SSHExecOptions {
+	o := &SSHExecOptions{
+		SSHOptions:  *NewSSHOptions(ioStreams),
+		Concurrency: 10,
+	}
+
+	// the command runs without a terminal, so there is no way to ask for confirmation
+	o.Interactive = false
+	o.NodeStrictHostKeyChecking = StrictHostKeyCheckingAcceptNew
+
+	return o
+}
+
+// AddFlags adds command-line flags to the flag set.
+func (o *SSHExecOptions) AddFlags(flagSet *pflag.FlagSet) {
+	flagSet.StringSliceVar(&o.Names, "node", o.Names, "Name of a node to run the command on. Can be specified multiple times.")
+	flagSet.StringVar(&o.Pool, "pool", o.Pool, "Run the command on the nodes of the given worker pool.")
+	flagSet.StringVar(&o.Zone, "zone", o.Zone, "Run the command on the nodes in the given availability zone.")
+	flagSet.StringVarP(&o.Selector, "selector", "l", o.Selector, "Run the command on the nodes matching the given label selector.")
+	flagSet.IntVar(&o.Concurrency, "concurrency", o.Concurrency, "Maximum number of nodes the command runs on at the same time.")
+	o.addBastionFlags(flagSet)
+	o.Options.AddFlags(flagSet)
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *SSHExecOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	o.Command = args
+
+	return o.SSHOptions.Complete(f, cmd, nil)
+}
+
+// Validate validates the provided SSHExecOptions.
+func (o *SSHExecOptions) Validate() error {
+	if len(o.Command) == 0 {
+		return errors.New("a command to run on the nodes is required")
+	}
+
+	if o.Concurrency < 1 {
+		return errors.New("--concurrency must be at least 1")
+	}
+
+	return o.SSHOptions.Validate()
+}
+
+// Run executes the command.
+func (o *SSHExecOptions) Run(f util.Factory) error {
+	// the session cannot be recorded, as no interactive shell is opened
+	if err := o.enforceSessionRecording(f, false); err != nil {
+		return err
+	}
+
+	return o.runWithBastion(f, nil, func(ctx context.Context, conn *bastionConnection) error {
+		logger := klog.FromContext(ctx)
+
+		nodes, err := o.selectNodes(ctx, conn.manager, conn.target, conn.shootClient)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Running command on nodes", "count", len(nodes), "concurrency", o.Concurrency)
+
+		var (
+			results  = make([]ExecResult, len(nodes))
+			outMutex sync.Mutex
+			errMutex sync.Mutex
+			wg       sync.WaitGroup
+		)
+
+		semaphore := make(chan struct{}, o.Concurrency)
+
+		for i, node := range nodes {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				select {
+				case semaphore <- struct{}{}:
+					defer func() { <-semaphore }()
+				case <-ctx.Done():
+					results[i] = ExecResult{Node: node.name, Hostname: node.hostname, ExitCode: -1, Error: ctx.Err().Error()}
+					return
+				}
+
+				var stdout, stderr bytes.Buffer
+
+				streams := util.IOStreams{In: strings.NewReader(""), Out: &stdout, ErrOut: &stderr}
+
+				var outWriter, errWriter *prefixWriter
+
+				if o.Output == "" {
+					outWriter = newPrefixWriter(o.IOStreams.Out, &outMutex, node.name)
+					errWriter = newPrefixWriter(o.IOStreams.ErrOut, &errMutex, node.name)
+					streams.Out = outWriter
+					streams.ErrOut = errWriter
+				}
+
+				results[i] = o.execOnNode(ctx, conn, node, streams)
+				results[i].Stdout = stdout.String()
+				results[i].Stderr = stderr.String()
+
+				if outWriter != nil {
+					outWriter.Flush()
+					errWriter.Flush()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		failed := 0
+
+		for _, result := range results {
+			if result.ExitCode == 0 {
+				continue
+			}
+
+			failed++
+
+			if o.Output == "" {
+				if result.Error != "" {
+					fmt.Fprintf(o.IOStreams.ErrOut, "[%s] failed to run command: %s\n", result.Node, result.Error)
+				} else {
+					fmt.Fprintf(o.IOStreams.ErrOut, "[%s] command exited with code %d\n", result.Node, result.ExitCode)
+				}
+			}
+		}
+
+		if o.Output != "" {
+			if err := o.PrintObject(results); err != nil {
+				return err
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("command failed on %d of %d nodes", failed, len(results))
+		}
+
+		return nil
+	})
+}
+
+// execOnNode runs the command on the given node and returns the result without the output.
+func (o *SSHExecOptions) execOnNode(ctx context.Context, conn *bastionConnection, node selectedNode, streams util.IOStreams) ExecResult {
+	commandArgs := sshExecCommandArguments(
+		conn.bastionAddress,
+		o.BastionPort,
+		o.SSHPrivateKeyFile,
+		o.SSHCertificateFile,
+		o.BastionUserKnownHostsFiles,
+		o.BastionStrictHostKeyChecking,
+		o.NodeUserKnownHostsFiles,
+		o.NodeStrictHostKeyChecking,
+		node.hostname,
+		conn.nodePrivateKeyFiles,
+		o.User,
+		o.Command,
+	)
+
+	var args []string
+
+	for _, arg := range commandArgs.list {
+		args = append(args, arg.value)
+	}
+
+	result := ExecResult{Node: node.name, Hostname: node.hostname}
+
+	err := execCommand(ctx, "ssh", args, streams)
+	if err == nil {
+		return result
+	}
+
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) && exitErr.ExitCode() >= 0 {
+		result.ExitCode = exitErr.ExitCode()
+	} else {
+		result.ExitCode = -1
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// prefixWriter writes complete lines prefixed with the name of a node. Writers of the same stream share
+// a mutex, so that lines of different nodes are not interleaved.
+type prefixWriter struct {
+	out    io.Writer
+	mutex  *sync.Mutex
+	prefix string
+	buf    []byte
+}
+
+var _ io.Writer = &prefixWriter{}
+
+func newPrefixWriter(out io.Writer, mutex *sync.Mutex, nodeName string) *prefixWriter {
+	return &prefixWriter{out: out, mutex: mutex, prefix: "[" + nodeName + "] "}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.writeLine(w.buf[:i+1])
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush writes the remaining incomplete line, if any.
+func (w *prefixWriter) Flush() {
+	if len(w.buf) > 0 {
+		w.writeLine(append(w.buf, '\n'))
+		w.buf = nil
+	}
+}
+
+func (w *prefixWriter) writeLine(line []byte) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	fmt.Fprintf(w.out, "%s%s", w.prefix, line)
+}
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/gardener/gardenctl-v2/internal/util"
 )
@@ -59,6 +61,7 @@ func SSHCommandArguments(
 	bastionHost string,
 	bastionPort string,
 	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
 	bastionUserKnownHostsFiles []string,
 	bastionStrictHostKeyChecking StrictHostKeyChecking,
 	nodeUserKnownHostsFiles []string,
@@ -66,12 +69,15 @@ func SSHCommandArguments(
 	nodeHostname string,
 	nodePrivateKeyFiles []PrivateKeyFile,
 	user string,
+	portForwards []PortForward,
+	forwardOnly bool,
 ) TestArguments {
 	return TestArguments{
 		sshCommandArguments(
 			bastionHost,
 			bastionPort,
 			sshPrivateKeyFile,
+			sshCertificateFile,
 			bastionUserKnownHostsFiles,
 			bastionStrictHostKeyChecking,
 			nodeUserKnownHostsFiles,
@@ -79,6 +85,101 @@ func SSHCommandArguments(
 			nodeHostname,
 			nodePrivateKeyFiles,
 			user,
+			portForwards,
+			forwardOnly,
 		),
 	}
 }
+
+// Values returns the arguments as they are passed to the command.
+func (a TestArguments) Values() []string {
+	var values []string
+	for _, arg := range a.list {
+		values = append(values, arg.value)
+	}
+
+	return values
+}
+
+func SSHExecCommandArguments(
+	bastionHost string,
+	bastionPort string,
+	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
+	bastionUserKnownHostsFiles []string,
+	bastionStrictHostKeyChecking StrictHostKeyChecking,
+	nodeUserKnownHostsFiles []string,
+	nodeStrictHostKeyChecking StrictHostKeyChecking,
+	nodeHostname string,
+	nodePrivateKeyFiles []PrivateKeyFile,
+	user string,
+	command []string,
+) TestArguments {
+	return TestArguments{
+		sshExecCommandArguments(
+			bastionHost,
+			bastionPort,
+			sshPrivateKeyFile,
+			sshCertificateFile,
+			bastionUserKnownHostsFiles,
+			bastionStrictHostKeyChecking,
+			nodeUserKnownHostsFiles,
+			nodeStrictHostKeyChecking,
+			nodeHostname,
+			nodePrivateKeyFiles,
+			user,
+			command,
+		),
+	}
+}
+
+func SCPCommandArguments(
+	bastionHost string,
+	bastionPort string,
+	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
+	bastionUserKnownHostsFiles []string,
+	bastionStrictHostKeyChecking StrictHostKeyChecking,
+	nodeUserKnownHostsFiles []string,
+	nodeStrictHostKeyChecking StrictHostKeyChecking,
+	nodeHostname string,
+	nodePrivateKeyFiles []PrivateKeyFile,
+	user string,
+	recursive bool,
+	operands []string,
+) TestArguments {
+	var scpOperands []scpOperand
+	for _, operand := range operands {
+		scpOperands = append(scpOperands, parseSCPOperand(operand))
+	}
+
+	return TestArguments{
+		scpCommandArguments(
+			bastionHost,
+			bastionPort,
+			sshPrivateKeyFile,
+			sshCertificateFile,
+			bastionUserKnownHostsFiles,
+			bastionStrictHostKeyChecking,
+			nodeUserKnownHostsFiles,
+			nodeStrictHostKeyChecking,
+			nodeHostname,
+			nodePrivateKeyFiles,
+			user,
+			recursive,
+			scpOperands,
+		),
+	}
+}
+
+func SetPick(f func(ioStreams util.IOStreams, prompt string, items []string) (string, error)) {
+	pick = f
+}
+
+func SetAttachToPod(f func(ctx context.Context, clientConfig clientcmd.ClientConfig, pod *corev1.Pod, ioStreams util.IOStreams) error) {
+	attachToPod = f
+}
+
+func SetPollDebugPodStatusInterval(d time.Duration) {
+	pollDebugPodStatusInterval = d
+}
@@ -50,6 +50,72 @@ func (s *StrictHostKeyChecking) String() string {
 	return string(*s)
 }
 
+// SSHClient defines the type for the SSH client used to connect to the shoot nodes.
+type SSHClient string
+
+const (
+	// SSHClientOpenSSH runs the external OpenSSH client binary.
+	SSHClientOpenSSH SSHClient = "openssh"
+	// SSHClientNative uses the SSH client built into gardenctl.
+	SSHClientNative SSHClient = "native"
+)
+
+var (
+	_ pflag.Value  = (*SSHClient)(nil)
+	_ fmt.Stringer = (*SSHClient)(nil)
+)
+
+func (s *SSHClient) Set(value string) error {
+	switch value {
+	case string(SSHClientOpenSSH), string(SSHClientNative):
+		*s = SSHClient(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid value %q for SSHClient. Valid options are 'openssh' or 'native'", value)
+	}
+}
+
+func (s *SSHClient) Type() string {
+	return "string"
+}
+
+func (s *SSHClient) String() string {
+	return string(*s)
+}
+
+// SSHMethod defines the type for the method used to access the shoot nodes.
+type SSHMethod string
+
+const (
+	// SSHMethodBastion connects to the node with SSH through a bastion.
+	SSHMethodBastion SSHMethod = "bastion"
+	// SSHMethodDebugPod attaches to a privileged pod that is scheduled on the node and chroots into its root filesystem.
+	SSHMethodDebugPod SSHMethod = "debug-pod"
+)
+
+var (
+	_ pflag.Value  = (*SSHMethod)(nil)
+	_ fmt.Stringer = (*SSHMethod)(nil)
+)
+
+func (s *SSHMethod) Set(value string) error {
+	switch value {
+	case string(SSHMethodBastion), string(SSHMethodDebugPod):
+		*s = SSHMethod(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid value %q for SSHMethod. Valid options are 'bastion' or 'debug-pod'", value)
+	}
+}
+
+func (s *SSHMethod) Type() string {
+	return "string"
+}
+
+func (s *SSHMethod) String() string {
+	return string(*s)
+}
+
 type PublicKeyFile string
 
 var (
@@ -91,3 +157,38 @@ func (s *PrivateKeyFile) Type() string {
 func (s *PrivateKeyFile) String() string {
 	return string(*s)
 }
+
+// SSHKeyType defines the type for the algorithm of generated SSH keypairs.
+type SSHKeyType string
+
+const (
+	// SSHKeyTypeRSA generates 3072 bit RSA keys.
+	SSHKeyTypeRSA SSHKeyType = "rsa"
+	// SSHKeyTypeED25519 generates Ed25519 keys.
+	SSHKeyTypeED25519 SSHKeyType = "ed25519"
+	// SSHKeyTypeECDSA generates ECDSA keys on the NIST P-256 curve.
+	SSHKeyTypeECDSA SSHKeyType = "ecdsa"
+)
+
+var (
+	_ pflag.Value  = (*SSHKeyType)(nil)
+	_ fmt.Stringer = (*SSHKeyType)(nil)
+)
+
+func (s *SSHKeyType) Set(value string) error {
+	switch value {
+	case string(SSHKeyTypeRSA), string(SSHKeyTypeED25519), string(SSHKeyTypeECDSA):
+		*s = SSHKeyType(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid value %q for SSHKeyType. Valid options are 'rsa', 'ed25519' or 'ecdsa'", value)
+	}
+}
+
+func (s *SSHKeyType) Type() string {
+	return "string"
+}
+
+func (s *SSHKeyType) String() string {
+	return string(*s)
+}
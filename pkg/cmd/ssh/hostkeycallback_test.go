@@ -346,6 +346,7 @@ var _ = Describe("realHostKeyCallbackFactory", func() {
 			Expect(errOutString).To(ContainSubstring(fmt.Sprintf("Add correct host key in %s to get rid of this message.", tempFile.Name())))
 			Expect(errOutString).To(ContainSubstring(fmt.Sprintf("Offending ssh-rsa key in %s:1", tempFile.Name())))
 			Expect(errOutString).To(ContainSubstring("Host key verification failed."))
+			Expect(errOutString).To(ContainSubstring("gardenctl ssh known-hosts forget"))
 		})
 
 		It("should handle host key mismatch with different key types", func() {
@@ -0,0 +1,719 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // hashed known_hosts entries use HMAC-SHA1
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+const (
+	// KnownHostsKindBastion marks the known_hosts files of bastions, which are stored below the garden temp directory.
+	KnownHostsKindBastion = "bastion"
+	// KnownHostsKindNode marks the known_hosts files of the nodes of a shoot, which are stored below the garden home directory.
+	KnownHostsKindNode = "node"
+
+	// bastionOwnerFileName is the name of the file that records the bastion a known_hosts file has been created for.
+	bastionOwnerFileName = "bastion.json"
+
+	// hashedHostPrefix is the prefix of host patterns that are hashed with HMAC-SHA1, see HashKnownHosts in ssh_config(5).
+	hashedHostPrefix = "|1|"
+)
+
+// NewCmdSSHKnownHosts returns a new ssh known-hosts command.
+func NewCmdSSHKnownHosts(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "known-hosts",
+		Short: "Manage the known_hosts files of bastions and Shoot cluster nodes",
+		Long: `Manage the known_hosts files in which gardenctl stores the host keys of bastions and Shoot cluster nodes.
+The host keys of a bastion are stored in <temp_dir>/garden/cache/<bastion_uid>/.ssh/known_hosts, the host keys of the nodes
+of a Shoot cluster in <garden_home_dir>/cache/<shoot_uid>/.ssh/known_hosts.
+
+As bastions and nodes are recreated frequently, these files collect entries of hosts that no longer exist. If the IP of such a
+node is reused by a new node, the connection fails because the remote host identification has changed. Use "prune" to remove
+the stale entries or "forget" to remove the entries of a single host.`,
+	}
+
+	cmd.AddCommand(NewCmdSSHKnownHostsList(f, ioStreams))
+	cmd.AddCommand(NewCmdSSHKnownHostsPrune(f, ioStreams))
+	cmd.AddCommand(NewCmdSSHKnownHostsForget(f, ioStreams))
+
+	return cmd
+}
+
+// NewCmdSSHKnownHostsList returns a new ssh known-hosts list command.
+func NewCmdSSHKnownHostsList(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &KnownHostsListOptions{
+		KnownHostsOptions: KnownHostsOptions{Options: base.Options{IOStreams: ioStreams}},
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the entries of the known_hosts files of bastions and Shoot cluster nodes",
+		Example: `# list the host keys of all bastions and Shoot cluster nodes
+gardenctl ssh known-hosts list
+
+# list the host keys in JSON format
+gardenctl ssh known-hosts list -o json`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	return cmd
+}
+
+// NewCmdSSHKnownHostsPrune returns a new ssh known-hosts prune command.
+func NewCmdSSHKnownHostsPrune(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &KnownHostsPruneOptions{
+		KnownHostsOptions: KnownHostsOptions{Options: base.Options{IOStreams: ioStreams}},
+	}
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove the known_hosts entries of bastions and nodes that no longer exist",
+		Long: `Remove the known_hosts entries of bastions and nodes that no longer exist.
+The known_hosts files of bastions of the targeted garden are removed if the bastion no longer exists. The known_hosts files of
+bastions of other gardens, and of bastions in projects whose bastions cannot be listed, are kept.
+If a Shoot cluster is targeted, the entries of its known_hosts file are removed if they do not belong to an address of one of its current nodes.`,
+		Example: `# remove the known_hosts files of deleted bastions and the entries of replaced nodes of the targeted shoot
+gardenctl ssh known-hosts prune
+
+# show what would be removed for shoot my-shoot of project my-project
+gardenctl ssh known-hosts prune --project my-project --shoot my-shoot --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", false, "Only print what would be removed")
+
+	f.TargetFlags().AddFlags(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
+
+// NewCmdSSHKnownHostsForget returns a new ssh known-hosts forget command.
+func NewCmdSSHKnownHostsForget(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &KnownHostsForgetOptions{
+		KnownHostsOptions: KnownHostsOptions{Options: base.Options{IOStreams: ioStreams}},
+	}
+	cmd := &cobra.Command{
+		Use:   "forget HOST",
+		Short: "Remove the known_hosts entries of a host",
+		Long: `Remove the known_hosts entries of a host from the known_hosts files of all bastions and Shoot cluster nodes.
+The host is an IP address or hostname, optionally followed by a port if it is not 22, like in ssh-keygen -R.`,
+		Example: `# forget the host key of a node that has been replaced
+gardenctl ssh known-hosts forget 10.250.0.7`,
+		Args: cobra.ExactArgs(1),
+		RunE: base.WrapRunE(o, f),
+	}
+
+	return cmd
+}
+
+// KnownHostsOptions contains the options shared by the ssh known-hosts commands.
+type KnownHostsOptions struct {
+	base.Options
+
+	// GardenTempDir is the directory below which the known_hosts files of bastions are stored
+	GardenTempDir string
+
+	// GardenHomeDir is the directory below which the known_hosts files of shoot nodes are stored
+	GardenHomeDir string
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *KnownHostsOptions) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	o.GardenTempDir = f.GardenTempDir()
+	o.GardenHomeDir = f.GardenHomeDir()
+
+	return nil
+}
+
+// knownHostsFiles returns the known_hosts files of bastions and shoot nodes managed by gardenctl.
+func (o *KnownHostsOptions) knownHostsFiles() ([]managedKnownHostsFile, error) {
+	var files []managedKnownHostsFile
+
+	for _, location := range []struct{ kind, dir string }{
+		{KnownHostsKindBastion, o.GardenTempDir},
+		{KnownHostsKindNode, o.GardenHomeDir},
+	} {
+		paths, err := filepath.Glob(filepath.Join(location.dir, "cache", "*", ".ssh", "known_hosts"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			files = append(files, managedKnownHostsFile{
+				Kind: location.kind,
+				UID:  filepath.Base(filepath.Dir(filepath.Dir(path))),
+				Path: path,
+			})
+		}
+	}
+
+	return files, nil
+}
+
+// KnownHostsListOptions contains the options for the ssh known-hosts list command.
+type KnownHostsListOptions struct {
+	KnownHostsOptions
+}
+
+// Run executes the command.
+func (o *KnownHostsListOptions) Run(_ util.Factory) error {
+	files, err := o.knownHostsFiles()
+	if err != nil {
+		return err
+	}
+
+	entries := []KnownHostEntry{}
+
+	for _, file := range files {
+		fileEntries, err := file.entries()
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, fileEntries...)
+	}
+
+	if o.Output != "" {
+		return o.PrintObject(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(o.IOStreams.ErrOut, "No known hosts entries found")
+		return nil
+	}
+
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Kind", Type: "string"},
+			{Name: "UID", Type: "string"},
+			{Name: "Hosts", Type: "string"},
+			{Name: "Key Type", Type: "string"},
+			{Name: "Fingerprint", Type: "string"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+
+	for _, entry := range entries {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{entry.Kind, entry.UID, strings.Join(entry.Hosts, ","), entry.KeyType, entry.Fingerprint},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+
+	return printer.PrintObj(table, o.IOStreams.Out)
+}
+
+// KnownHostsPruneOptions contains the options for the ssh known-hosts prune command.
+type KnownHostsPruneOptions struct {
+	KnownHostsOptions
+
+	// DryRun defines if the entries are only printed instead of removed
+	DryRun bool
+}
+
+// Run executes the command.
+func (o *KnownHostsPruneOptions) Run(f util.Factory) error {
+	ctx := f.Context()
+
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		return err
+	}
+
+	if currentTarget.GardenName() == "" {
+		return target.ErrNoGardenTargeted
+	}
+
+	gardenClient, err := manager.GardenClient(currentTarget.GardenName())
+	if err != nil {
+		return fmt.Errorf("failed to create garden cluster client: %w", err)
+	}
+
+	files, err := o.knownHostsFiles()
+	if err != nil {
+		return err
+	}
+
+	deletedBastionUIDs, err := deletedBastionUIDs(ctx, gardenClient, currentTarget.GardenName(), files)
+	if err != nil {
+		return err
+	}
+
+	var (
+		shootUID      string
+		nodeAddresses sets.Set[string]
+	)
+
+	if currentTarget.ShootName() != "" {
+		shoot, err := gardenClient.FindShoot(ctx, currentTarget.AsListOption())
+		if err != nil {
+			return err
+		}
+
+		shootClient, err := manager.ShootClient(ctx, currentTarget)
+		if err != nil {
+			return err
+		}
+
+		nodes, err := getNodes(ctx, shootClient)
+		if err != nil {
+			return err
+		}
+
+		shootUID = string(shoot.UID)
+		nodeAddresses = sets.New[string]()
+
+		for _, node := range nodes {
+			for _, address := range node.Status.Addresses {
+				nodeAddresses.Insert(address.Address)
+			}
+		}
+	}
+
+	verb := "Removed"
+	if o.DryRun {
+		verb = "Would remove"
+	}
+
+	pruned := 0
+
+	for _, file := range files {
+		switch {
+		case file.Kind == KnownHostsKindBastion && deletedBastionUIDs.Has(file.UID):
+			if !o.DryRun {
+				if err := file.remove(); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(o.IOStreams.Out, "%s known hosts of deleted bastion %s (%s)\n", verb, file.UID, file.Path)
+
+			pruned++
+		case file.Kind == KnownHostsKindNode && file.UID == shootUID:
+			removed, err := file.removeEntries(func(entry KnownHostEntry) bool {
+				return !entry.matchesAnyAddress(nodeAddresses.UnsortedList())
+			}, o.DryRun)
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range removed {
+				fmt.Fprintf(o.IOStreams.Out, "%s %s key of %s (%s:%d)\n", verb, entry.KeyType, strings.Join(entry.Hosts, ","), entry.File, entry.Line)
+			}
+
+			pruned += len(removed)
+		}
+	}
+
+	if pruned == 0 {
+		fmt.Fprintln(o.IOStreams.Out, "No stale known hosts entries found")
+	}
+
+	return nil
+}
+
+// deletedBastionUIDs returns the UIDs of the bastions of the given known_hosts files that no longer exist in the garden.
+// Bastions are only considered deleted if their known_hosts file has been created for a bastion of the garden and the
+// bastions of its namespace can be listed, the known_hosts files of bastions of other gardens are kept.
+func deletedBastionUIDs(ctx context.Context, gardenClient clientgarden.Client, gardenName string, files []managedKnownHostsFile) (sets.Set[string], error) {
+	logger := klog.FromContext(ctx)
+
+	deleted := sets.New[string]()
+	// existing contains the UIDs of the bastions by namespace, or nil if they cannot be listed
+	existing := map[string]sets.Set[string]{}
+
+	for _, file := range files {
+		if file.Kind != KnownHostsKindBastion {
+			continue
+		}
+
+		owner, err := readBastionOwner(file.cacheDir())
+		if err != nil {
+			return nil, err
+		}
+
+		if owner == nil || owner.Garden != gardenName {
+			logger.V(1).Info("Skipping known hosts file of a bastion of another garden", "knownHostsFile", file.Path)
+			continue
+		}
+
+		uids, ok := existing[owner.Namespace]
+		if !ok {
+			bastions, err := gardenClient.ListBastions(ctx, client.InNamespace(owner.Namespace))
+			if apierrors.IsForbidden(err) {
+				logger.Info("Skipping known hosts files of bastions, as the bastions of the namespace cannot be listed", "namespace", owner.Namespace)
+			} else if err != nil {
+				return nil, err
+			} else {
+				uids = sets.New[string]()
+				for _, bastion := range bastions.Items {
+					uids.Insert(string(bastion.UID))
+				}
+			}
+
+			existing[owner.Namespace] = uids
+		}
+
+		if uids != nil && !uids.Has(file.UID) {
+			deleted.Insert(file.UID)
+		}
+	}
+
+	return deleted, nil
+}
+
+// bastionOwner identifies the bastion a known_hosts file in the garden temp directory has been created for.
+// As the directory is shared by all gardens, it is required to attribute the file to a garden.
+type bastionOwner struct {
+	// Garden is the name of the garden of the bastion
+	Garden string `json:"garden"`
+	// Namespace is the namespace of the bastion
+	Namespace string `json:"namespace"`
+	// Name is the name of the bastion
+	Name string `json:"name"`
+}
+
+// writeBastionOwner records the bastion in the cache directory of its known_hosts file.
+func writeBastionOwner(cacheDir string, owner bastionOwner) error {
+	data, err := json.Marshal(owner)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(cacheDir, bastionOwnerFileName), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write bastion owner file: %w", err)
+	}
+
+	return nil
+}
+
+// readBastionOwner returns the bastion recorded in the cache directory of a known_hosts file, or nil if none has been
+// recorded, e.g. because the file has been created by an older version of gardenctl.
+func readBastionOwner(cacheDir string) (*bastionOwner, error) {
+	data, err := os.ReadFile(filepath.Join(cacheDir, bastionOwnerFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read bastion owner file: %w", err)
+	}
+
+	owner := &bastionOwner{}
+	if err := json.Unmarshal(data, owner); err != nil {
+		return nil, fmt.Errorf("invalid bastion owner file in %s: %w", cacheDir, err)
+	}
+
+	return owner, nil
+}
+
+// KnownHostsForgetOptions contains the options for the ssh known-hosts forget command.
+type KnownHostsForgetOptions struct {
+	KnownHostsOptions
+
+	// Host is the host whose entries are removed
+	Host string
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *KnownHostsForgetOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Host = strings.TrimSpace(args[0])
+	}
+
+	return o.KnownHostsOptions.Complete(f, cmd, args)
+}
+
+// Validate validates the provided options.
+func (o *KnownHostsForgetOptions) Validate() error {
+	if o.Host == "" {
+		return errors.New("the host must not be empty")
+	}
+
+	return o.KnownHostsOptions.Validate()
+}
+
+// Run executes the command.
+func (o *KnownHostsForgetOptions) Run(_ util.Factory) error {
+	files, err := o.knownHostsFiles()
+	if err != nil {
+		return err
+	}
+
+	forgotten := 0
+
+	for _, file := range files {
+		removed, err := file.removeEntries(func(entry KnownHostEntry) bool {
+			return entry.matchesHost(o.Host)
+		}, false)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range removed {
+			fmt.Fprintf(o.IOStreams.Out, "Removed %s key of %s (%s:%d)\n", entry.KeyType, strings.Join(entry.Hosts, ","), entry.File, entry.Line)
+		}
+
+		forgotten += len(removed)
+	}
+
+	if forgotten == 0 {
+		fmt.Fprintf(o.IOStreams.Out, "No known hosts entries found for %s\n", o.Host)
+	}
+
+	return nil
+}
+
+// managedKnownHostsFile is a known_hosts file of a bastion or of the nodes of a shoot that is managed by gardenctl.
+type managedKnownHostsFile struct {
+	// Kind is either KnownHostsKindBastion or KnownHostsKindNode
+	Kind string
+	// UID is the UID of the bastion or of the shoot
+	UID string
+	// Path is the path of the known_hosts file
+	Path string
+}
+
+// KnownHostEntry is an entry of a known_hosts file managed by gardenctl.
+type KnownHostEntry struct {
+	// Kind is either "bastion" or "node"
+	Kind string `json:"kind"`
+	// UID is the UID of the bastion or of the shoot the known_hosts file belongs to
+	UID string `json:"uid"`
+	// File is the path of the known_hosts file
+	File string `json:"file"`
+	// Line is the line number of the entry in the known_hosts file
+	Line int `json:"line"`
+	// Marker is the optional marker of the entry, either "@cert-authority" or "@revoked"
+	Marker string `json:"marker,omitempty"`
+	// Hosts are the host patterns of the entry, which may be hashed
+	Hosts []string `json:"hosts"`
+	// KeyType is the type of the host key
+	KeyType string `json:"keyType"`
+	// Fingerprint is the SHA256 fingerprint of the host key
+	Fingerprint string `json:"fingerprint"`
+}
+
+// entries returns the entries of the known_hosts file.
+func (f managedKnownHostsFile) entries() ([]KnownHostEntry, error) {
+	var entries []KnownHostEntry
+
+	err := f.scan(func(_ string, entry *KnownHostEntry) {
+		if entry != nil {
+			entries = append(entries, *entry)
+		}
+	})
+
+	return entries, err
+}
+
+// removeEntries removes the entries for which remove returns true and returns them. The file is not changed in dryRun mode.
+func (f managedKnownHostsFile) removeEntries(remove func(KnownHostEntry) bool, dryRun bool) ([]KnownHostEntry, error) {
+	var (
+		removed []KnownHostEntry
+		kept    bytes.Buffer
+	)
+
+	err := f.scan(func(line string, entry *KnownHostEntry) {
+		if entry != nil && remove(*entry) {
+			removed = append(removed, *entry)
+			return
+		}
+
+		kept.WriteString(line + "\n")
+	})
+	if err != nil || len(removed) == 0 || dryRun {
+		return removed, err
+	}
+
+	if err := os.WriteFile(f.Path, kept.Bytes(), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write known hosts file: %w", err)
+	}
+
+	return removed, nil
+}
+
+// cacheDir returns the cache directory of the bastion or shoot the known_hosts file belongs to.
+func (f managedKnownHostsFile) cacheDir() string {
+	return filepath.Dir(filepath.Dir(f.Path))
+}
+
+// remove removes the known_hosts file, the bastion owner file and the directories if they are empty.
+func (f managedKnownHostsFile) remove() error {
+	for _, path := range []string{f.Path, filepath.Join(f.cacheDir(), bastionOwnerFileName)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove known hosts file: %w", err)
+		}
+	}
+
+	// the directories are only removed if they are empty, other files are kept
+	if err := os.Remove(filepath.Dir(f.Path)); err == nil {
+		_ = os.Remove(f.cacheDir())
+	}
+
+	return nil
+}
+
+// scan calls fn for every line of the known_hosts file, with the parsed entry or nil for empty lines and comments.
+func (f managedKnownHostsFile) scan(fn func(line string, entry *KnownHostEntry)) error {
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read known hosts file: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		marker, hosts, key, _, _, err := ssh.ParseKnownHosts([]byte(line))
+		if errors.Is(err, io.EOF) {
+			// empty line or comment
+			fn(line, nil)
+
+			continue
+		} else if err != nil {
+			return fmt.Errorf("invalid known hosts entry in %s:%d: %w", f.Path, lineNumber, err)
+		}
+
+		entry := &KnownHostEntry{
+			Kind:        f.Kind,
+			UID:         f.UID,
+			File:        f.Path,
+			Line:        lineNumber,
+			Hosts:       hosts,
+			KeyType:     key.Type(),
+			Fingerprint: ssh.FingerprintSHA256(key),
+		}
+
+		if marker != "" {
+			entry.Marker = "@" + marker
+		}
+
+		fn(line, entry)
+	}
+
+	return scanner.Err()
+}
+
+// matchesHost returns true if one of the host patterns of the entry is the given host, which is normalized like by the
+// knownhosts package, i.e. the port is removed if it is 22.
+func (e KnownHostEntry) matchesHost(host string) bool {
+	normalized := knownhosts.Normalize(host)
+
+	for _, pattern := range e.Hosts {
+		if strings.HasPrefix(pattern, hashedHostPrefix) {
+			if matchesHashedHost(pattern, normalized) {
+				return true
+			}
+
+			continue
+		}
+
+		if pattern == normalized {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesAnyAddress returns true if one of the host patterns of the entry refers to one of the addresses, regardless of the port.
+func (e KnownHostEntry) matchesAnyAddress(addresses []string) bool {
+	for _, pattern := range e.Hosts {
+		if strings.HasPrefix(pattern, hashedHostPrefix) {
+			for _, address := range addresses {
+				if matchesHashedHost(pattern, knownhosts.Normalize(address)) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		host := pattern
+		if strings.HasPrefix(pattern, "[") {
+			if h, _, err := net.SplitHostPort(pattern); err == nil {
+				host = h
+			}
+		}
+
+		for _, address := range addresses {
+			if host == address {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesHashedHost returns true if the hashed host pattern "|1|salt|hash" has been created for the host.
+func matchesHashedHost(pattern, host string) bool {
+	parts := strings.Split(strings.TrimPrefix(pattern, hashedHostPrefix), "|")
+	if len(parts) != 2 {
+		return false
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha1.New, salt)
+	mac.Write([]byte(host))
+
+	return hmac.Equal(mac.Sum(nil), hash)
+}
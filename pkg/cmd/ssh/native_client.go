@@ -0,0 +1,287 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
+	"k8s.io/klog/v2"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+// terminalSizePollInterval is the interval in which the size of the local terminal is checked
+// to propagate changes to the remote pseudo terminal.
+const terminalSizePollInterval = 500 * time.Millisecond
+
+// nativeConnection contains the information required to open an SSH session to a shoot node through the
+// bastion with the in-process SSH client.
+type nativeConnection struct {
+	// bastionHost is the hostname or IP address of the bastion
+	bastionHost string
+	// bastionPort is the SSH port of the bastion
+	bastionPort string
+	// sshPrivateKeyFile is the private SSH key for the bastion, the SSH agent is used if it is empty
+	sshPrivateKeyFile PrivateKeyFile
+	// sshCertificateFile is the SSH certificate for the private key, which is presented to the bastion and the node if set
+	sshCertificateFile string
+	// bastionHostKeyCallback verifies the host key of the bastion
+	bastionHostKeyCallback ssh.HostKeyCallback
+	// nodeHostname is the hostname or IP address of the node
+	nodeHostname string
+	// nodePort is the SSH port of the node
+	nodePort string
+	// nodePrivateKeyFiles are the private SSH keys for the node
+	nodePrivateKeyFiles []PrivateKeyFile
+	// nodeHostKeyCallback verifies the host key of the node
+	nodeHostKeyCallback ssh.HostKeyCallback
+	// user is the name of the SSH user on the node
+	user string
+}
+
+// nativeRemoteShell opens an interactive shell on the node with the in-process SSH client. It dials the bastion,
+// tunnels to the node with a direct-tcpip channel and requests a pseudo terminal if the input is a terminal.
+func nativeRemoteShell(ctx context.Context, ioStreams util.IOStreams, conn *nativeConnection) error {
+	logger := klog.FromContext(ctx)
+
+	nodeClient, closeClients, err := dialNode(conn)
+	if err != nil {
+		return err
+	}
+	defer closeClients()
+
+	// the clients are closed if the context is cancelled, e.g. because the bastion is gone
+	stop := context.AfterFunc(ctx, closeClients)
+	defer stop()
+
+	session, err := nodeClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed to open SSH session: %w", err)
+	}
+	defer session.Close()
+
+	session.Stdin = ioStreams.In
+	session.Stdout = ioStreams.Out
+	session.Stderr = ioStreams.ErrOut
+
+	if file, ok := ioStreams.In.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		restore, err := requestPty(ctx, session, file, ioStreams.Out)
+		if err != nil {
+			return err
+		}
+		defer restore()
+	} else {
+		logger.V(4).Info("Input is not a terminal, not requesting a pseudo terminal")
+	}
+
+	if err := session.Shell(); err != nil {
+		return fmt.Errorf("failed to start remote shell: %w", err)
+	}
+
+	return session.Wait()
+}
+
+// dialNode connects to the bastion and opens an SSH connection to the node through a direct-tcpip channel.
+// The returned function closes both connections.
+func dialNode(conn *nativeConnection) (*ssh.Client, func(), error) {
+	var privateKey []byte
+
+	if conn.sshPrivateKeyFile != "" {
+		var err error
+
+		privateKey, err = os.ReadFile(conn.sshPrivateKeyFile.String())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read SSH private key from %q: %w", conn.sshPrivateKeyFile, err)
+		}
+	}
+
+	bastionAuth, closeAgent, err := bastionAuthMethods(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeAgent()
+
+	var certSigner ssh.Signer
+
+	if conn.sshCertificateFile != "" && len(privateKey) > 0 {
+		var signer ssh.Signer
+
+		certSigner, signer, err = newCertSigner(conn.sshCertificateFile, privateKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the certificate is offered first, the private key remains as fallback
+		bastionAuth = []ssh.AuthMethod{ssh.PublicKeys(certSigner, signer)}
+	}
+
+	bastionClient, err := ssh.Dial("tcp", net.JoinHostPort(conn.bastionHost, conn.bastionPort), &ssh.ClientConfig{
+		User:            SSHBastionUsername,
+		Auth:            bastionAuth,
+		HostKeyCallback: conn.bastionHostKeyCallback,
+		Timeout:         10 * time.Second,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to bastion: %w", err)
+	}
+
+	nodeSigners, err := parsePrivateKeyFiles(conn.nodePrivateKeyFiles)
+	if err != nil {
+		_ = bastionClient.Close()
+		return nil, nil, err
+	}
+
+	if certSigner != nil {
+		nodeSigners = append([]ssh.Signer{certSigner}, nodeSigners...)
+	}
+
+	nodeAddress := net.JoinHostPort(conn.nodeHostname, conn.nodePort)
+
+	tunnel, err := bastionClient.Dial("tcp", nodeAddress)
+	if err != nil {
+		_ = bastionClient.Close()
+		return nil, nil, fmt.Errorf("failed to open tunnel to node %s through the bastion: %w", nodeAddress, err)
+	}
+
+	clientConn, chans, reqs, err := ssh.NewClientConn(tunnel, nodeAddress, &ssh.ClientConfig{
+		User:            conn.user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(nodeSigners...)},
+		HostKeyCallback: conn.nodeHostKeyCallback,
+		Timeout:         10 * time.Second,
+	})
+	if err != nil {
+		_ = tunnel.Close()
+		_ = bastionClient.Close()
+
+		return nil, nil, fmt.Errorf("failed to connect to node %s: %w", nodeAddress, err)
+	}
+
+	nodeClient := ssh.NewClient(clientConn, chans, reqs)
+
+	return nodeClient, func() {
+		_ = nodeClient.Close()
+		_ = bastionClient.Close()
+	}, nil
+}
+
+// parsePrivateKeyFiles returns the signers for the given private key files.
+func parsePrivateKeyFiles(files []PrivateKeyFile) ([]ssh.Signer, error) {
+	signers := make([]ssh.Signer, 0, len(files))
+
+	for _, file := range files {
+		privateKey, err := os.ReadFile(file.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read node private key from %q: %w", file, err)
+		}
+
+		signer, err := ssh.ParsePrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node private key %q: %w", file, err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
+// requestPty switches the local terminal to raw mode and requests a pseudo terminal of the same size for the
+// session. Size changes of the local terminal are propagated until the context is cancelled or the returned
+// function is called, which also restores the local terminal.
+func requestPty(ctx context.Context, session *ssh.Session, in *os.File, out io.Writer) (func(), error) {
+	sizeFd := int(in.Fd())
+	if file, ok := out.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		sizeFd = int(file.Fd())
+	}
+
+	width, height, err := term.GetSize(sizeFd)
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	termType := os.Getenv("TERM")
+	if termType == "" {
+		termType = "xterm-256color"
+	}
+
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+
+	if err := session.RequestPty(termType, height, width, modes); err != nil {
+		return nil, fmt.Errorf("failed to request pseudo terminal: %w", err)
+	}
+
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to switch terminal to raw mode: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		ticker := time.NewTicker(terminalSizePollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w, h, err := term.GetSize(sizeFd)
+				if err != nil || (w == width && h == height) {
+					continue
+				}
+
+				width, height = w, h
+				_ = session.WindowChange(height, width)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+
+		_ = term.Restore(int(in.Fd()), state)
+	}, nil
+}
+
+// nativeConnectionFor returns the connection information for the in-process SSH client.
+func (o *SSHOptions) nativeConnectionFor(conn *bastionConnection) (*nativeConnection, error) {
+	bastionHostKeyCallback, err := o.HostKeyCallbackFactory.New(o.BastionStrictHostKeyChecking, o.BastionUserKnownHostsFiles, o.IOStreams)
+	if err != nil {
+		return nil, fmt.Errorf("could not create bastion hostkey callback: %w", err)
+	}
+
+	nodeHostKeyCallback, err := o.HostKeyCallbackFactory.New(o.NodeStrictHostKeyChecking, o.NodeUserKnownHostsFiles, o.IOStreams)
+	if err != nil {
+		return nil, fmt.Errorf("could not create node hostkey callback: %w", err)
+	}
+
+	return &nativeConnection{
+		bastionHost:            conn.bastionAddress,
+		bastionPort:            o.BastionPort,
+		sshPrivateKeyFile:      o.SSHPrivateKeyFile,
+		sshCertificateFile:     o.SSHCertificateFile,
+		bastionHostKeyCallback: bastionHostKeyCallback,
+		nodeHostname:           conn.nodeHostname,
+		nodePort:               strconv.Itoa(SSHPort),
+		nodePrivateKeyFiles:    conn.nodePrivateKeyFiles,
+		nodeHostKeyCallback:    nodeHostKeyCallback,
+		user:                   o.User,
+	}, nil
+}
@@ -0,0 +1,287 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+// testSSHServer is a minimal SSH server for testing the native SSH client.
+type testSSHServer struct {
+	listener net.Listener
+	config   *ssh.ServerConfig
+	hostKey  ssh.Signer
+
+	// forwardTo is the address direct-tcpip channels are forwarded to, they are rejected if it is empty
+	forwardTo string
+
+	mutex           sync.Mutex
+	forwardRequests []string
+	requests        []string
+}
+
+func newTestSSHServer(authorizedKey ssh.PublicKey, user string) *testSSHServer {
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	hostKey, err := ssh.NewSignerFromKey(hostPrivateKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() != user || string(key.Marshal()) != string(authorizedKey.Marshal()) {
+				return nil, fmt.Errorf("unknown public key for %q", conn.User())
+			}
+
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	s := &testSSHServer{listener: listener, config: config, hostKey: hostKey}
+
+	go s.serve()
+
+	return s
+}
+
+func (s *testSSHServer) address() string {
+	return s.listener.Addr().String()
+}
+
+func (s *testSSHServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *testSSHServer) handle(conn net.Conn) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		return
+	}
+
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		switch newChannel.ChannelType() {
+		case "direct-tcpip":
+			s.forward(newChannel)
+		case "session":
+			s.session(newChannel)
+		default:
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+		}
+	}
+}
+
+func (s *testSSHServer) forward(newChannel ssh.NewChannel) {
+	var payload struct {
+		Host       string
+		Port       uint32
+		OriginHost string
+		OriginPort uint32
+	}
+
+	if err := ssh.Unmarshal(newChannel.ExtraData(), &payload); err != nil || s.forwardTo == "" {
+		_ = newChannel.Reject(ssh.Prohibited, "forwarding not allowed")
+		return
+	}
+
+	s.mutex.Lock()
+	s.forwardRequests = append(s.forwardRequests, net.JoinHostPort(payload.Host, fmt.Sprint(payload.Port)))
+	s.mutex.Unlock()
+
+	target, err := net.Dial("tcp", s.forwardTo)
+	if err != nil {
+		_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+
+	channel, reqs, err := newChannel.Accept()
+	if err != nil {
+		_ = target.Close()
+		return
+	}
+
+	go ssh.DiscardRequests(reqs)
+
+	go func() {
+		_, _ = io.Copy(channel, target)
+		_ = channel.Close()
+	}()
+
+	go func() {
+		_, _ = io.Copy(target, channel)
+		_ = target.Close()
+	}()
+}
+
+func (s *testSSHServer) session(newChannel ssh.NewChannel) {
+	channel, reqs, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+
+	go func() {
+		for req := range reqs {
+			s.mutex.Lock()
+			s.requests = append(s.requests, req.Type)
+			s.mutex.Unlock()
+
+			_ = req.Reply(req.Type == "pty-req" || req.Type == "shell", nil)
+
+			if req.Type == "shell" {
+				_, _ = fmt.Fprintln(channel, "hello from node")
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				_ = channel.Close()
+			}
+		}
+	}()
+}
+
+func (s *testSSHServer) close() {
+	_ = s.listener.Close()
+}
+
+var _ = Describe("nativeRemoteShell", func() {
+	var (
+		tempDir          string
+		bastion          *testSSHServer
+		node             *testSSHServer
+		bastionKeyFile   PrivateKeyFile
+		nodeKeyFile      PrivateKeyFile
+		knownHostsFile   string
+		ioStreams        util.IOStreams
+		out              *util.SafeBytesBuffer
+		hostKeyCallbacks HostKeyCallbackFactory
+	)
+
+	writePrivateKey := func(name string) (PrivateKeyFile, ssh.PublicKey) {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		block, err := ssh.MarshalPrivateKey(privateKey, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		signer, err := ssh.NewSignerFromKey(privateKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		file := filepath.Join(tempDir, name)
+		Expect(writeKeyFile(file, pem.EncodeToMemory(block))).To(Succeed())
+
+		return PrivateKeyFile(file), signer.PublicKey()
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		tempDir, err = os.MkdirTemp("", "native-client-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		var bastionPublicKey, nodePublicKey ssh.PublicKey
+
+		bastionKeyFile, bastionPublicKey = writePrivateKey("bastion")
+		nodeKeyFile, nodePublicKey = writePrivateKey("node")
+
+		node = newTestSSHServer(nodePublicKey, "gardener")
+		bastion = newTestSSHServer(bastionPublicKey, SSHBastionUsername)
+		bastion.forwardTo = node.address()
+
+		// the node is addressed by its hostname, but reached through the tunnel of the bastion
+		knownHostsFile = filepath.Join(tempDir, "known_hosts")
+		Expect(os.WriteFile(knownHostsFile, []byte(
+			knownhosts.Line([]string{bastion.address()}, bastion.hostKey.PublicKey())+"\n"+
+				knownhosts.Line([]string{"node.invalid"}, node.hostKey.PublicKey())+"\n",
+		), 0o600)).To(Succeed())
+
+		ioStreams, _, out, _ = util.NewTestIOStreams()
+		hostKeyCallbacks = NewRealHostKeyCallbackFactory()
+	})
+
+	AfterEach(func() {
+		bastion.close()
+		node.close()
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	newConnection := func() *nativeConnection {
+		bastionHost, bastionPort, err := net.SplitHostPort(bastion.address())
+		Expect(err).NotTo(HaveOccurred())
+
+		hostKeyCallback, err := hostKeyCallbacks.New(StrictHostKeyCheckingYes, []string{knownHostsFile}, ioStreams)
+		Expect(err).NotTo(HaveOccurred())
+
+		return &nativeConnection{
+			bastionHost:            bastionHost,
+			bastionPort:            bastionPort,
+			sshPrivateKeyFile:      bastionKeyFile,
+			bastionHostKeyCallback: hostKeyCallback,
+			nodeHostname:           "node.invalid",
+			nodePort:               "22",
+			nodePrivateKeyFiles:    []PrivateKeyFile{nodeKeyFile},
+			nodeHostKeyCallback:    hostKeyCallback,
+			user:                   "gardener",
+		}
+	}
+
+	It("should open a shell on the node through the bastion", func() {
+		Expect(nativeRemoteShell(context.Background(), ioStreams, newConnection())).To(Succeed())
+
+		Expect(out.String()).To(Equal("hello from node\n"))
+		Expect(bastion.forwardRequests).To(Equal([]string{"node.invalid:22"}))
+		Expect(node.requests).To(ContainElement("shell"))
+		// the input is not a terminal
+		Expect(node.requests).NotTo(ContainElement("pty-req"))
+	})
+
+	It("should verify the host key of the node", func() {
+		Expect(os.WriteFile(knownHostsFile, []byte(
+			knownhosts.Line([]string{bastion.address()}, bastion.hostKey.PublicKey())+"\n"+
+				knownhosts.Line([]string{"node.invalid"}, bastion.hostKey.PublicKey())+"\n",
+		), 0o600)).To(Succeed())
+
+		err := nativeRemoteShell(context.Background(), ioStreams, newConnection())
+		Expect(err).To(MatchError(ContainSubstring("failed to connect to node node.invalid:22")))
+		Expect(err).To(MatchError(ContainSubstring("key mismatch")))
+	})
+
+	It("should fail if the node private key is not authorized", func() {
+		otherKeyFile, _ := writePrivateKey("other")
+
+		conn := newConnection()
+		conn.nodePrivateKeyFiles = []PrivateKeyFile{otherKeyFile}
+
+		Expect(nativeRemoteShell(context.Background(), ioStreams, conn)).To(MatchError(ContainSubstring("unable to authenticate")))
+		Expect(strings.Count(out.String(), "hello")).To(BeZero())
+	})
+})
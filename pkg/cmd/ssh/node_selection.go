@@ -0,0 +1,180 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// NodeSelection selects shoot cluster nodes by name, worker pool, zone or label selector.
+// An empty selection selects all nodes.
+type NodeSelection struct {
+	// Names are the names of the selected nodes
+	Names []string
+	// Pool is the name of the worker pool of the selected nodes
+	Pool string
+	// Zone is the availability zone of the selected nodes
+	Zone string
+	// Selector is a label selector for the selected nodes
+	Selector string
+}
+
+// selectedNode is a shoot cluster node that has been selected to connect to.
+type selectedNode struct {
+	// name is the name of the node
+	name string
+	// hostname is the address used to connect to the node
+	hostname string
+}
+
+// isEmpty returns true if no selection criteria are set.
+func (s NodeSelection) isEmpty() bool {
+	return len(s.Names) == 0 && s.Pool == "" && s.Zone == "" && s.Selector == ""
+}
+
+// labelSelector returns the label selector of the selection, including the worker pool and zone.
+func (s NodeSelection) labelSelector() (labels.Selector, error) {
+	selector, err := labels.Parse(s.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", s.Selector, err)
+	}
+
+	if s.Pool != "" {
+		requirement, err := labels.NewRequirement(corev1beta1constants.LabelWorkerPool, selection.Equals, []string{s.Pool})
+		if err != nil {
+			return nil, fmt.Errorf("invalid worker pool %q: %w", s.Pool, err)
+		}
+
+		selector = selector.Add(*requirement)
+	}
+
+	if s.Zone != "" {
+		requirement, err := labels.NewRequirement(corev1.LabelTopologyZone, selection.Equals, []string{s.Zone})
+		if err != nil {
+			return nil, fmt.Errorf("invalid zone %q: %w", s.Zone, err)
+		}
+
+		selector = selector.Add(*requirement)
+	}
+
+	return selector, nil
+}
+
+// selectNodes returns the selected nodes of the shoot of the given target sorted by name. Nodes that have not yet
+// joined the cluster can only be selected by name or by an empty selection, and are addressed by their name.
+func (s NodeSelection) selectNodes(ctx context.Context, manager target.Manager, shootTarget target.Target, shootClient client.Client) ([]selectedNode, error) {
+	logger := klog.FromContext(ctx)
+
+	selector, err := s.labelSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	nodes := map[string]*corev1.Node{}
+	for i := range nodeList.Items {
+		nodes[nodeList.Items[i].Name] = &nodeList.Items[i]
+	}
+
+	var names []string
+
+	if selector.Empty() {
+		names, err = getNodeNamesFromMachinesOrNodes(ctx, manager, shootTarget)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node names: %w", err)
+		}
+	} else {
+		for name := range nodes {
+			names = append(names, name)
+		}
+	}
+
+	if len(s.Names) > 0 {
+		available := map[string]bool{}
+		for _, name := range names {
+			available[name] = true
+		}
+
+		for _, name := range s.Names {
+			if !available[name] {
+				return nil, fmt.Errorf("node %q not found or not matching the selection", name)
+			}
+		}
+
+		names = s.Names
+	}
+
+	sort.Strings(names)
+
+	var selected []selectedNode
+
+	for _, name := range names {
+		if len(selected) > 0 && selected[len(selected)-1].name == name {
+			continue // skip duplicates
+		}
+
+		hostname := name
+
+		if node, ok := nodes[name]; ok {
+			hostname, err = getNodeHostname(node)
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine hostname for node %q: %w", name, err)
+			}
+		} else {
+			logger.Info("Node did not yet join the cluster, using its name as hostname", "nodeName", name)
+		}
+
+		selected = append(selected, selectedNode{name: name, hostname: hostname})
+	}
+
+	if len(selected) == 0 {
+		return nil, errors.New("no nodes match the selection")
+	}
+
+	return selected, nil
+}
+
+// readyNodeNames returns the sorted names of the ready nodes matching the label selector of the selection.
+func (s NodeSelection) readyNodeNames(ctx context.Context, shootClient client.Client) ([]string, error) {
+	selector, err := s.labelSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var names []string
+
+	for _, node := range nodeList.Items {
+		if isNodeReady(node) {
+			names = append(names, node.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
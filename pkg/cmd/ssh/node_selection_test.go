@@ -0,0 +1,49 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	internalfake "github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("selectNodes", func() {
+	It("should select all nodes of the given target without reading the current target", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		// the shoot of a managed seed, which has been resolved from a target without shoot
+		shootTarget := target.NewTarget("mygarden", "garden", "", "managed-seed")
+
+		shootClient := internalfake.NewClientWithObjects(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.250.0.2"}},
+			},
+		})
+
+		manager := targetmocks.NewMockManager(ctrl)
+		manager.EXPECT().GardenClient("mygarden").Return(nil, errors.New("no access to machines"))
+		manager.EXPECT().ShootClient(ctx, shootTarget).Return(shootClient, nil)
+
+		nodes, err := NodeSelection{}.selectNodes(ctx, manager, shootTarget, shootClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodes).To(Equal([]selectedNode{{name: "node1", hostname: "10.250.0.2"}}))
+	})
+})
@@ -8,17 +8,26 @@ package ssh
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	mathrand "math/rand/v2"
 	"net"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +56,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
 	"github.com/gardener/gardenctl-v2/internal/util"
 	"github.com/gardener/gardenctl-v2/pkg/ac"
 	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
@@ -87,31 +97,11 @@ var (
 		privateKey []byte,
 		hostKeyCallback ssh.HostKeyCallback,
 	) error {
-		var authMethods []ssh.AuthMethod
-
-		if len(privateKey) > 0 {
-			signer, err := ssh.ParsePrivateKey(privateKey)
-			if err != nil {
-				return fmt.Errorf("invalid private SSH key: %w", err)
-			}
-
-			authMethods = append(authMethods, ssh.PublicKeys(signer))
-		} else if addr := os.Getenv("SSH_AUTH_SOCK"); len(addr) > 0 {
-			socket, dialErr := net.Dial("unix", addr)
-			if dialErr != nil {
-				return fmt.Errorf("could not open SSH agent socket %q: %w", addr, dialErr)
-			}
-			defer socket.Close()
-
-			signers, signersErr := agent.NewClient(socket).Signers()
-			if signersErr != nil {
-				return fmt.Errorf("error when creating signer for SSH agent: %w", signersErr)
-			}
-
-			authMethods = append(authMethods, ssh.PublicKeys(signers...))
-		} else {
-			return errors.New("neither private key nor the environment variable SSH_AUTH_SOCK are defined, cannot connect to bastion")
+		authMethods, closeAgent, err := bastionAuthMethods(privateKey)
+		if err != nil {
+			return err
 		}
+		defer closeAgent()
 
 		client, err := ssh.Dial("tcp", net.JoinHostPort(hostname, port), &ssh.ClientConfig{
 			User:            SSHBastionUsername,
@@ -167,6 +157,9 @@ var (
 		// keep the bastion alive until gardenctl exits
 		<-signalChan
 	}
+
+	// pick lets the user select a node interactively
+	pick = util.Pick
 )
 
 // SSHOptions contains all the configurable options for the SSH command.
@@ -183,6 +176,14 @@ type SSHOptions struct {
 	// automatically generated.
 	BastionName string
 
+	// GeneratedBastionName is true if the bastion name has been generated instead of being provided by the user.
+	GeneratedBastionName bool
+
+	// ReuseBastion controls whether a ready bastion of the current user for the targeted shoot, which has been
+	// created within the same gardenctl session, is reused instead of creating a new bastion. A reused bastion is
+	// only deleted once the last command using it exits. It only applies if no BastionName is provided.
+	ReuseBastion bool
+
 	// BastionHost overrides the hostname or IP address of the Bastion used for the SSH command.
 	// If not provided, the address will be determined from .status.ingress.ip or
 	// status.ingress.hostname of the Bastion.
@@ -219,6 +220,41 @@ type SSHOptions struct {
 	// private SSH key. If not set, gardenctl relies on the user's SSH agent.
 	SSHPrivateKeyFile PrivateKeyFile
 
+	// KeyType is the algorithm of the generated SSH keypair. If not set, the key type configured for the
+	// targeted garden is used, which defaults to rsa.
+	KeyType SSHKeyType
+
+	// Client is the SSH client used to open the interactive session to the node. The native client
+	// does not require an external ssh binary.
+	Client SSHClient
+
+	// Method is the method used to access the node. The debug-pod method does not require a bastion, but attaches
+	// to a privileged pod on the node that is scheduled with the shoot cluster client.
+	Method SSHMethod
+
+	// DebugPodImage is the container image of the debug pod.
+	DebugPodImage string
+
+	// DebugPodNamespace is the namespace of the shoot cluster in which the debug pod is created.
+	DebugPodNamespace string
+
+	// EmitSSHConfig writes an ssh_config file with Host entries for the bastion and the nodes,
+	// which can be included into the user's SSH configuration.
+	EmitSSHConfig bool
+
+	// Record records the output of the interactive SSH session to an asciicast v2 file in the garden home directory.
+	// Recording is enforced for gardens that have recordSSHSessions enabled in the gardenctl configuration.
+	Record bool
+
+	// SSHConfigFile is the path of the generated ssh_config file. It is set when the file has been written
+	// and used for the cleanup.
+	SSHConfigFile string
+
+	// SSHCertificateFile is the path of the SSH certificate for the public SSH key, which is issued if an SSH
+	// certificate authority is configured for the targeted garden. It is set when the file has been written
+	// and used for the cleanup.
+	SSHCertificateFile string
+
 	// GeneratedSSHKeys is true if the public and private SSH keys have been generated
 	// instead of being provided by the user. This will then be used for the cleanup.
 	GeneratedSSHKeys bool
@@ -245,6 +281,20 @@ type SSHOptions struct {
 	// In this case, the access restriction banner is displayed without further confirmation.
 	ConfirmAccessRestriction bool
 
+	// PortForwards are the local (ssh -L) and remote (ssh -R) port forwardings that are opened
+	// through the bastion for the SSH connection to the shoot node.
+	PortForwards []PortForward
+
+	// ForwardOnly controls if only the port forwardings are opened without starting a remote shell.
+	ForwardOnly bool
+
+	// NodeSelection selects the node by worker pool, zone or label selector if no NodeName is given.
+	// If several ready nodes match, the user can choose one interactively.
+	NodeSelection
+
+	// Random selects a random ready node matching the NodeSelection instead of asking the user.
+	Random bool
+
 	// HostKeyCallbackFactory is used to create SSH host key callbacks based on the StrictHostKeyChecking setting.
 	HostKeyCallbackFactory HostKeyCallbackFactory
 }
@@ -259,6 +309,11 @@ func NewSSHOptions(ioStreams util.IOStreams) *SSHOptions {
 		Interactive:                  true,
 		WaitTimeout:                  10 * time.Minute,
 		KeepBastion:                  false,
+		ReuseBastion:                 true,
+		Client:                       SSHClientOpenSSH,
+		Method:                       SSHMethodBastion,
+		DebugPodImage:                "busybox",
+		DebugPodNamespace:            metav1.NamespaceDefault,
 		SkipAvailabilityCheck:        false,
 		NoKeepalive:                  false,
 		BastionPort:                  strconv.Itoa(SSHPort),
@@ -272,12 +327,33 @@ func NewSSHOptions(ioStreams util.IOStreams) *SSHOptions {
 // AddFlags adds command-line flags to the flag set.
 func (o *SSHOptions) AddFlags(flagSet *pflag.FlagSet) {
 	flagSet.BoolVar(&o.Interactive, "interactive", o.Interactive, "Open an SSH connection instead of just providing the bastion host (only if NODE_NAME is provided).")
+	flagSet.BoolVar(&o.NoKeepalive, "no-keepalive", o.NoKeepalive, "Exit after the bastion host became available without keeping the bastion alive or establishing an SSH connection. Note that this flag requires the flags --interactive=false and --keep-bastion to be set")
+	flagSet.Var(newPortForwardsValue(&o.PortForwards, false), "forward", "Forward a local port to a host and port as seen from the node, in the format [bind_address:]port:host:hostport (like ssh -L). Can be specified multiple times.")
+	flagSet.Var(newPortForwardsValue(&o.PortForwards, true), "remote-forward", "Forward a port on the node to a host and port as seen from the local machine, in the format [bind_address:]port:host:hostport (like ssh -R). Can be specified multiple times.")
+	flagSet.StringVar(&o.Pool, "pool", o.Pool, "Connect to a ready node of the given worker pool instead of specifying NODE_NAME.")
+	flagSet.StringVar(&o.Zone, "zone", o.Zone, "Connect to a ready node in the given availability zone instead of specifying NODE_NAME.")
+	flagSet.StringVarP(&o.Selector, "selector", "l", o.Selector, "Connect to a ready node matching the given label selector instead of specifying NODE_NAME.")
+	flagSet.BoolVar(&o.Random, "random", o.Random, "Connect to a random ready node matching --pool, --zone and --selector. If several nodes match and this flag is not set, the node can be chosen interactively.")
+	flagSet.Var(&o.Client, "client", "SSH client used to connect to the node. Valid options are 'openssh' to run the ssh binary, or 'native' to use the SSH client built into gardenctl, which does not support port forwarding.")
+	flagSet.Var(&o.Method, "method", "Method used to access the node. Valid options are 'bastion' to connect with SSH through a bastion, or 'debug-pod' to attach to a privileged pod that is scheduled on the node, for infrastructures without bastion support. The debug-pod method only supports interactive sessions.")
+	flagSet.StringVar(&o.DebugPodImage, "debug-pod-image", o.DebugPodImage, "Container image of the debug pod, which must provide a chroot binary. Only used with --method debug-pod.")
+	flagSet.StringVar(&o.DebugPodNamespace, "debug-pod-namespace", o.DebugPodNamespace, "Namespace of the Shoot cluster in which the debug pod is created. Only used with --method debug-pod.")
+	flagSet.BoolVar(&o.Record, "record", o.Record, "Record the output of the interactive SSH session to an asciicast v2 file in the recordings directory of the garden home directory. Always enabled for gardens that have recordSSHSessions set in the gardenctl configuration.")
+	flagSet.BoolVar(&o.EmitSSHConfig, "emit-ssh-config", o.EmitSSHConfig, "Write an ssh_config file with Host entries for the bastion and the nodes, and print the Include line to add to ~/.ssh/config. The file is removed when the bastion is cleaned up. Requires --interactive=false.")
+	flagSet.BoolVar(&o.ForwardOnly, "forward-only", o.ForwardOnly, "Only open the port forwardings without starting a remote shell (like ssh -N). The bastion is kept alive until gardenctl is stopped.")
+	o.addBastionFlags(flagSet)
+	o.Options.AddFlags(flagSet)
+}
+
+// addBastionFlags adds the flags for creating the bastion and connecting to the shoot node through it.
+func (o *SSHOptions) addBastionFlags(flagSet *pflag.FlagSet) {
 	flagSet.Var(&o.SSHPublicKeyFile, "public-key-file", "Path to the file that contains a public SSH key. If not given, a temporary keypair will be generated.")
 	flagSet.Var(&o.SSHPrivateKeyFile, "private-key-file", "Path to the file that contains a private SSH key. Must be provided alongside the --public-key-file flag if you want to use a custom keypair. If not provided, gardenctl will either generate a temporary keypair or rely on the user's SSH agent for an available private key.")
+	flagSet.Var(&o.KeyType, "key-type", "Algorithm of the generated SSH keypair. Valid options are 'rsa', 'ed25519' or 'ecdsa'. If not provided, the sshKeyType of the targeted garden in the gardenctl configuration is used, which defaults to 'rsa'. Ignored if --public-key-file is set.")
 	flagSet.DurationVar(&o.WaitTimeout, "wait-timeout", o.WaitTimeout, "Maximum duration to wait for the bastion to become available.")
 	flagSet.BoolVar(&o.KeepBastion, "keep-bastion", o.KeepBastion, "Do not delete immediately when gardenctl exits (Bastions will be garbage-collected after some time)")
 	flagSet.BoolVar(&o.SkipAvailabilityCheck, "skip-availability-check", o.SkipAvailabilityCheck, "Skip checking for SSH bastion host availability.")
-	flagSet.BoolVar(&o.NoKeepalive, "no-keepalive", o.NoKeepalive, "Exit after the bastion host became available without keeping the bastion alive or establishing an SSH connection. Note that this flag requires the flags --interactive=false and --keep-bastion to be set")
+	flagSet.BoolVar(&o.ReuseBastion, "reuse-bastion", o.ReuseBastion, "Reuse a ready bastion for the targeted shoot that has been created by a previous command of the same gardenctl session, instead of creating a new bastion. The bastion is deleted once the last command using it exits. Ignored if --bastion-name is set.")
 	flagSet.StringVar(&o.BastionName, "bastion-name", o.BastionName, "Name of the bastion. If a bastion with this name doesn't exist, it will be created. If it does exist, the provided public SSH key must match the one used during the bastion's creation.")
 	flagSet.StringVar(&o.BastionHost, "bastion-host", o.BastionHost, "Override the hostname or IP address of the bastion used for the SSH client command. If not provided, the address will be automatically determined.")
 	flagSet.StringVar(&o.BastionPort, "bastion-port", o.BastionPort, "SSH port of the bastion used for the SSH client command. Defaults to port 22")
@@ -287,7 +363,6 @@ func (o *SSHOptions) AddFlags(flagSet *pflag.FlagSet) {
 	flagSet.Var(&o.NodeStrictHostKeyChecking, "node-strict-host-key-checking", "Specifies how the SSH client performs host key checking for the shoot node. Valid options are 'yes', 'no', or 'ask'.")
 	flagSet.BoolVarP(&o.ConfirmAccessRestriction, "confirm-access-restriction", "y", o.ConfirmAccessRestriction, "Bypasses the need for confirmation of any access restrictions. Set this flag only if you are fully aware of the access restrictions.")
 	flagSet.StringVar(&o.User, "user", o.User, "user is the name of the Shoot cluster node ssh login username.")
-	o.Options.AddFlags(flagSet)
 }
 
 func (o *SSHOptions) RegisterCompletionFuncsForStrictHostKeyCheckings(cmd *cobra.Command) {
@@ -307,17 +382,55 @@ func (o *SSHOptions) RegisterCompletionFuncsForStrictHostKeyCheckings(cmd *cobra
 	}))
 }
 
+// registerCompletionFuncForKeyType registers the completion function for the --key-type flag added by addBastionFlags.
+func registerCompletionFuncForKeyType(cmd *cobra.Command) {
+	utilruntime.Must(cmd.RegisterFlagCompletionFunc("key-type", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{
+			string(SSHKeyTypeRSA) + "\t3072 bit RSA key",
+			string(SSHKeyTypeED25519) + "\tEd25519 key",
+			string(SSHKeyTypeECDSA) + "\tECDSA key on the NIST P-256 curve",
+		}, cobra.ShellCompDirectiveNoFileComp
+	}))
+}
+
 // Complete adapts from the command line args to the data required.
 func (o *SSHOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
 	ctx := f.Context()
 	logger := klog.FromContext(ctx)
 
+	// the debug pod is created with the shoot cluster client and requires neither a bastion nor SSH keys
+	if o.Method != SSHMethodDebugPod {
+		if err := o.completeBastion(f, cmd, args); err != nil {
+			return err
+		}
+	}
+
+	if len(args) > 0 {
+		o.NodeName = strings.TrimSpace(args[0])
+	}
+
+	if o.NodeName == "" && o.Interactive && !o.selectsNode() {
+		logger.V(4).Info("no node name given, switching to non-interactive mode")
+
+		o.Interactive = false
+	}
+
+	return nil
+}
+
+// completeBastion completes the access configuration, the SSH keypair and the name of the bastion.
+func (o *SSHOptions) completeBastion(f util.Factory, cmd *cobra.Command, args []string) error {
 	if err := o.AccessConfig.Complete(f, cmd, args); err != nil {
 		return err
 	}
 
 	if len(o.SSHPublicKeyFile) == 0 {
-		privateKeyFile, publicKeyFile, err := createSSHKeypair("", "")
+		keyType, err := o.sshKeyType(f)
+		if err != nil {
+			return err
+		}
+
+		privateKeyFile, publicKeyFile, err := createSSHKeypair("", "", keyType)
 		if err != nil {
 			return fmt.Errorf("failed to generate SSH keypair: %w", err)
 		}
@@ -336,16 +449,6 @@ func (o *SSHOptions) Complete(f util.Factory, cmd *cobra.Command, args []string)
 		}
 	}
 
-	if len(args) > 0 {
-		o.NodeName = strings.TrimSpace(args[0])
-	}
-
-	if o.NodeName == "" && o.Interactive {
-		logger.V(4).Info("no node name given, switching to non-interactive mode")
-
-		o.Interactive = false
-	}
-
 	if o.BastionName == "" {
 		name, err := bastionNameProvider()
 		if err != nil {
@@ -353,6 +456,7 @@ func (o *SSHOptions) Complete(f util.Factory, cmd *cobra.Command, args []string)
 		}
 
 		o.BastionName = name
+		o.GeneratedBastionName = true
 	}
 
 	return nil
@@ -389,6 +493,10 @@ func (o *SSHOptions) Validate() error {
 		return err
 	}
 
+	if o.Method == SSHMethodDebugPod {
+		return o.validateDebugPod()
+	}
+
 	if err := o.AccessConfig.Validate(); err != nil {
 		return err
 	}
@@ -413,10 +521,38 @@ func (o *SSHOptions) Validate() error {
 		}
 	}
 
+	if o.EmitSSHConfig && o.Interactive {
+		return errors.New("set --interactive=false when emitting an SSH config")
+	}
+
+	if o.Record && !o.recordable() {
+		return errors.New("session recording requires an interactive SSH session to a node without --forward-only")
+	}
+
 	if o.User == "" {
 		return errors.New("user must not be empty")
 	}
 
+	if o.NodeName != "" && o.selectsNode() {
+		return errors.New("NODE_NAME cannot be combined with --pool, --zone, --selector or --random")
+	}
+
+	if _, err := o.labelSelector(); err != nil {
+		return err
+	}
+
+	if len(o.PortForwards) > 0 && (!o.Interactive || (o.NodeName == "" && !o.selectsNode())) {
+		return errors.New("port forwarding requires a NODE_NAME and --interactive=true")
+	}
+
+	if o.Client == SSHClientNative && len(o.PortForwards) > 0 {
+		return errors.New("port forwarding is not supported by the native SSH client")
+	}
+
+	if o.ForwardOnly && len(o.PortForwards) == 0 {
+		return errors.New("set --forward or --remote-forward when using --forward-only")
+	}
+
 	content, err := os.ReadFile(o.SSHPublicKeyFile.String())
 	if err != nil {
 		return fmt.Errorf("invalid SSH public key file: %w", err)
@@ -429,32 +565,117 @@ func (o *SSHOptions) Validate() error {
 	return nil
 }
 
-func createSSHKeypair(tempDir string, keyName string) (PrivateKeyFile, PublicKeyFile, error) {
+// validateDebugPod validates the options for the debug-pod method, which only supports interactive sessions.
+func (o *SSHOptions) validateDebugPod() error {
+	if o.WaitTimeout == 0 {
+		return errors.New("the maximum wait duration must be non-zero")
+	}
+
+	if !o.Interactive {
+		return errors.New("the debug-pod method requires NODE_NAME, --pool, --zone, --selector or --random and --interactive=true")
+	}
+
+	if o.Output != "" || o.EmitSSHConfig || o.NoKeepalive {
+		return errors.New("the debug-pod method only supports interactive sessions, --output, --emit-ssh-config and --no-keepalive cannot be used")
+	}
+
+	if len(o.PortForwards) > 0 || o.ForwardOnly {
+		return errors.New("port forwarding is not supported by the debug-pod method")
+	}
+
+	if o.Client == SSHClientNative {
+		return errors.New("the native SSH client cannot be used with the debug-pod method")
+	}
+
+	if o.Record {
+		return errors.New("session recording is not supported by the debug-pod method")
+	}
+
+	if o.NodeName != "" && o.selectsNode() {
+		return errors.New("NODE_NAME cannot be combined with --pool, --zone, --selector or --random")
+	}
+
+	if _, err := o.labelSelector(); err != nil {
+		return err
+	}
+
+	if o.DebugPodImage == "" {
+		return errors.New("the debug pod image must not be empty")
+	}
+
+	if o.DebugPodNamespace == "" {
+		return errors.New("the debug pod namespace must not be empty")
+	}
+
+	return nil
+}
+
+// sshKeyType returns the algorithm for the generated SSH keypair. The --key-type flag takes precedence over the
+// sshKeyType of the targeted garden, which defaults to rsa.
+func (o *SSHOptions) sshKeyType(f util.Factory) (SSHKeyType, error) {
+	if o.KeyType != "" {
+		return o.KeyType, nil
+	}
+
+	manager, err := f.Manager()
+	if err != nil {
+		return "", err
+	}
+
+	// the target is validated when running the command, the default is sufficient if it cannot be determined here
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil || currentTarget.GardenName() == "" {
+		return SSHKeyTypeRSA, nil
+	}
+
+	garden, err := manager.Configuration().Garden(currentTarget.GardenName())
+	if err != nil {
+		return "", err
+	}
+
+	if garden.SSHKeyType == "" {
+		return SSHKeyTypeRSA, nil
+	}
+
+	var keyType SSHKeyType
+	if err := keyType.Set(garden.SSHKeyType); err != nil {
+		return "", fmt.Errorf("invalid sshKeyType of garden %q: %w", garden.Name, err)
+	}
+
+	return keyType, nil
+}
+
+func createSSHKeypair(tempDir string, keyName string, keyType SSHKeyType) (PrivateKeyFile, PublicKeyFile, error) {
 	if keyName == "" {
 		id, err := utils.GenerateRandomString(8)
 		if err != nil {
 			return "", "", fmt.Errorf("failed to create key name: %w", err)
 		}
 
-		keyName = fmt.Sprintf("gen_id_rsa_%s", strings.ToLower(id))
+		keyName = fmt.Sprintf("gen_id_%s_%s", keyType, strings.ToLower(id))
 	}
 
-	privateKey, err := createSSHPrivateKey()
+	privateKey, err := createSSHPrivateKey(keyType)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create private key: %w", err)
 	}
 
-	publicKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+	publicKey, err := ssh.NewPublicKey(privateKey.Public())
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create public key: %w", err)
 	}
 
+	encodedPrivateKey, err := encodePrivateKey(privateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode private key: %w", err)
+	}
+
 	if tempDir == "" {
 		tempDir = os.TempDir()
 	}
 
 	sshPrivateKeyFile := PrivateKeyFile(filepath.Join(tempDir, keyName))
-	if err := writeKeyFile(sshPrivateKeyFile.String(), encodePrivateKey(privateKey)); err != nil {
+	if err := writeKeyFile(sshPrivateKeyFile.String(), encodedPrivateKey); err != nil {
 		return "", "", fmt.Errorf("failed to write private key: %w", err)
 	}
 
@@ -466,27 +687,48 @@ func createSSHKeypair(tempDir string, keyName string) (PrivateKeyFile, PublicKey
 	return sshPrivateKeyFile, sshPublicKeyFile, nil
 }
 
-func createSSHPrivateKey() (*rsa.PrivateKey, error) {
-	// Private Key generation
-	privateKey, err := rsa.GenerateKey(rand.Reader, 3072)
-	if err != nil {
-		return nil, err
+func createSSHPrivateKey(keyType SSHKeyType) (crypto.Signer, error) {
+	switch keyType {
+	case SSHKeyTypeED25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	case SSHKeyTypeECDSA:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case SSHKeyTypeRSA, "":
+		// Private Key generation
+		privateKey, err := rsa.GenerateKey(rand.Reader, 3072)
+		if err != nil {
+			return nil, err
+		}
+
+		// Validate Private Key
+		err = privateKey.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		return privateKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported SSH key type %q", keyType)
+	}
+}
+
+// encodePrivateKey returns the PEM encoded private key. RSA keys are encoded in the PKCS #1 format, all other
+// key types in the OpenSSH format.
+func encodePrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		return pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(rsaPrivateKey),
+		}), nil
 	}
 
-	// Validate Private Key
-	err = privateKey.Validate()
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
 	if err != nil {
 		return nil, err
 	}
 
-	return privateKey, nil
-}
-
-func encodePrivateKey(privateKey *rsa.PrivateKey) []byte {
-	return pem.EncodeToMemory(&pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
-	})
+	return pem.EncodeToMemory(block), nil
 }
 
 func encodePublicKey(publicKey ssh.PublicKey) []byte {
@@ -501,6 +743,37 @@ func writeKeyFile(filename string, content []byte) error {
 	return nil
 }
 
+// bastionAuthMethods returns the SSH authentication methods for the bastion. If no private key is given, the
+// signers of the SSH agent are used. The returned function closes the connection to the SSH agent.
+func bastionAuthMethods(privateKey []byte) ([]ssh.AuthMethod, func(), error) {
+	if len(privateKey) > 0 {
+		signer, err := ssh.ParsePrivateKey(privateKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid private SSH key: %w", err)
+		}
+
+		return []ssh.AuthMethod{ssh.PublicKeys(signer)}, func() {}, nil
+	}
+
+	addr := os.Getenv("SSH_AUTH_SOCK")
+	if len(addr) == 0 {
+		return nil, nil, errors.New("neither private key nor the environment variable SSH_AUTH_SOCK are defined, cannot connect to bastion")
+	}
+
+	socket, err := net.Dial("unix", addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open SSH agent socket %q: %w", addr, err)
+	}
+
+	signers, err := agent.NewClient(socket).Signers()
+	if err != nil {
+		_ = socket.Close()
+		return nil, nil, fmt.Errorf("error when creating signer for SSH agent: %w", err)
+	}
+
+	return []ssh.AuthMethod{ssh.PublicKeys(signers...)}, func() { _ = socket.Close() }, nil
+}
+
 func countSSHAgentSigners() (int, error) {
 	addr := os.Getenv("SSH_AUTH_SOCK")
 	if len(addr) == 0 {
@@ -521,35 +794,132 @@ func countSSHAgentSigners() (int, error) {
 	return len(signers), nil
 }
 
+// bastionConnection contains the information required to connect to a shoot node through a ready bastion.
+type bastionConnection struct {
+	// manager is the target manager
+	manager target.Manager
+	// target is the target of the connection, which refers to the shoot of a managed seed if a seed is targeted
+	target target.Target
+	// shootClient is the client for the targeted shoot cluster
+	shootClient client.Client
+	// bastion is the ready bastion
+	bastion *operationsv1alpha1.Bastion
+	// bastionAddress is the preferred address of the bastion
+	bastionAddress string
+	// nodeHostname is the hostname of the node, empty if no node name was given
+	nodeHostname string
+	// nodePrivateKeyFiles are the temporary files containing the private SSH keys of the shoot nodes
+	nodePrivateKeyFiles []PrivateKeyFile
+	// gardenClient is the client for the garden cluster
+	gardenClient clientgarden.Client
+	// gardenHomeDir is the gardenctl home directory
+	gardenHomeDir string
+	// accessRestrictions are the access restrictions of the shoot that have been shown to the user
+	accessRestrictions ac.AccessRestrictionMessages
+}
+
 func (o *SSHOptions) Run(f util.Factory) error {
+	if err := o.enforceSessionRecording(f, o.recordable()); err != nil {
+		return err
+	}
+
+	if o.Method == SSHMethodDebugPod {
+		return o.runWithDebugPod(f)
+	}
+
+	return o.runWithBastion(f, o.selectNode, o.connect)
+}
+
+// recordable returns true if gardenctl opens an interactive shell on the node through a bastion, which can be recorded.
+func (o *SSHOptions) recordable() bool {
+	return o.Interactive && !o.ForwardOnly && o.Method != SSHMethodDebugPod
+}
+
+// enforceSessionRecording enables the session recording if it is mandatory for the targeted garden. An error is
+// returned if the session cannot be recorded, e.g. because gardenctl only creates the bastion or runs a command
+// on the nodes with ssh exec, scp or ssh proxy. Every command that accesses the nodes must call it before the
+// bastion is created.
+func (o *SSHOptions) enforceSessionRecording(f util.Factory, recordable bool) error {
 	manager, err := f.Manager()
 	if err != nil {
 		return err
 	}
 
-	ctx := f.Context()
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		return err
+	}
+
+	if currentTarget.GardenName() == "" {
+		return nil // reported when running the command
+	}
+
+	garden, err := manager.Configuration().Garden(currentTarget.GardenName())
+	if err != nil {
+		return err
+	}
+
+	if !garden.RecordSSHSessions {
+		return nil
+	}
+
+	if !recordable {
+		return fmt.Errorf("SSH sessions must be recorded for garden %q, connect to a node interactively through a bastion without --forward-only", garden.Name)
+	}
+
+	if !o.Record {
+		klog.FromContext(f.Context()).Info("Recording the SSH session, as it is mandatory for the garden", "garden", garden.Name)
+
+		o.Record = true
+	}
+
+	return nil
+}
+
+// targetedShoot is the shoot whose nodes are accessed.
+type targetedShoot struct {
+	// manager is the target manager
+	manager target.Manager
+	// target is the target of the shoot, which refers to the shoot of a managed seed if a seed is targeted
+	target target.Target
+	// gardenClient is the client for the garden cluster
+	gardenClient clientgarden.Client
+	// shoot is the targeted shoot
+	shoot *gardencorev1beta1.Shoot
+	// accessRestrictions are the access restrictions of the shoot that have been shown to the user
+	accessRestrictions ac.AccessRestrictionMessages
+}
+
+// resolveTargetedShoot fetches the targeted shoot, or the shoot of the targeted managed seed, and checks its access
+// restrictions. nil is returned if the user did not confirm the access restrictions.
+func (o *SSHOptions) resolveTargetedShoot(ctx context.Context, f util.Factory) (*targetedShoot, error) {
 	logger := klog.FromContext(ctx)
 
+	manager, err := f.Manager()
+	if err != nil {
+		return nil, err
+	}
+
 	// currentTarget is the target used for the run method
 	currentTarget, err := manager.CurrentTarget()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// create client for the garden cluster
 	gardenClient, err := manager.GardenClient(currentTarget.GardenName())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if currentTarget.ShootName() == "" && currentTarget.SeedName() != "" {
 		shoot, err := gardenClient.GetShootOfManagedSeed(ctx, currentTarget.SeedName())
 		if err != nil {
 			if apierrors.IsNotFound(err) {
-				return fmt.Errorf("cannot ssh to non-managed seeds: %w", err)
+				return nil, fmt.Errorf("cannot ssh to non-managed seeds: %w", err)
 			}
 
-			return err
+			return nil, err
 		}
 
 		logger.V(1).Info("using referred shoot of managed seed",
@@ -563,28 +933,55 @@ func (o *SSHOptions) Run(f util.Factory) error {
 	}
 
 	if currentTarget.ShootName() == "" {
-		return target.ErrNoShootTargeted
+		return nil, target.ErrNoShootTargeted
 	}
 
 	printTargetInformation(logger, currentTarget)
 
-	// fetch targeted shoot (ctx is cancellable to stop the keep alive goroutine later)
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	shoot, err := gardenClient.FindShoot(ctx, currentTarget.AsListOption())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// check access restrictions
-	ok, err := o.checkAccessRestrictions(manager.Configuration(), currentTarget.GardenName(), f.TargetFlags(), shoot)
+	accessRestrictions, ok, err := o.checkAccessRestrictions(manager.Configuration(), currentTarget.GardenName(), f.TargetFlags(), shoot)
 	if err != nil {
-		return err
+		return nil, err
 	} else if !ok {
-		return nil // abort
+		return nil, nil // abort
+	}
+
+	return &targetedShoot{
+		manager:            manager,
+		target:             currentTarget,
+		gardenClient:       gardenClient,
+		shoot:              shoot,
+		accessRestrictions: accessRestrictions,
+	}, nil
+}
+
+// runWithBastion creates a bastion for the targeted shoot, keeps it alive while connect is running and cleans up afterwards.
+// If selectNode is not nil, it is called before the bastion is created to determine the node to connect to.
+// The context passed to connect is cancelled if gardenctl receives an interrupt signal or the bastion is gone.
+func (o *SSHOptions) runWithBastion(
+	f util.Factory,
+	selectNode func(ctx context.Context, shootClient client.Client) error,
+	connect func(ctx context.Context, conn *bastionConnection) error,
+) error {
+	ctx := f.Context()
+	logger := klog.FromContext(ctx)
+
+	// fetch targeted shoot (ctx is cancellable to stop the keep alive goroutine later)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ts, err := o.resolveTargetedShoot(ctx, f)
+	if err != nil || ts == nil {
+		return err
 	}
 
+	manager, currentTarget, gardenClient, shoot := ts.manager, ts.target, ts.gardenClient, ts.shoot
+
 	workersSettings := shoot.Spec.Provider.WorkersSettings
 	if workersSettings != nil && workersSettings.SSHAccess != nil && !workersSettings.SSHAccess.Enabled {
 		return errors.New("node SSH access disabled, SSH not allowed")
@@ -613,6 +1010,12 @@ func (o *SSHOptions) Run(f util.Factory) error {
 		return err
 	}
 
+	if selectNode != nil {
+		if err := selectNode(ctx, shootClient); err != nil {
+			return err
+		}
+	}
+
 	var nodeHostname string
 
 	if o.NodeName != "" {
@@ -636,6 +1039,24 @@ func (o *SSHOptions) Run(f util.Factory) error {
 		return fmt.Errorf("failed to get bastion ingress policies: %w", err)
 	}
 
+	var (
+		store         *bastionStore
+		reusedBastion *operationsv1alpha1.Bastion
+	)
+
+	if o.ReuseBastion && o.GeneratedBastionName {
+		store = newBastionStore(manager.SessionDir())
+
+		reusedBastion, err = o.findReusableBastion(ctx, gardenClient, store, shoot)
+		if err != nil {
+			return fmt.Errorf("failed to find a reusable bastion: %w", err)
+		}
+
+		if reusedBastion != nil {
+			o.BastionName = reusedBastion.Name
+		}
+	}
+
 	sshPublicKey, err := os.ReadFile(o.SSHPublicKeyFile.String())
 	if err != nil {
 		return fmt.Errorf("failed to read SSH public key: %w", err)
@@ -646,6 +1067,17 @@ func (o *SSHOptions) Run(f util.Factory) error {
 		Name:      o.BastionName,
 	}
 
+	var lease *bastionLease
+
+	if store != nil {
+		lease, err = store.acquire(bastionKey)
+		if err != nil {
+			return err
+		}
+
+		go keepBastionLeaseAlive(ctx, lease)
+	}
+
 	// allow to cancel at any time, but with us still performing the cleanup
 	signalChan := createSignalChannel()
 
@@ -662,13 +1094,38 @@ func (o *SSHOptions) Run(f util.Factory) error {
 	}()
 
 	// do not use `ctx`, as it might be cancelled already when running the cleanup
-	defer cleanup(f.Context(), o, gardenClient.RuntimeClient(), bastionKey, nodePrivateKeyFiles)
+	defer cleanup(f.Context(), o, gardenClient.RuntimeClient(), bastionKey, nodePrivateKeyFiles, store, lease)
 
-	bastion, err := createOrPatchBastion(ctx, gardenClient.RuntimeClient(), bastionKey, shoot, sshPublicKey, policies)
-	if err != nil {
+	if err := o.createSSHCertificate(ctx, manager.Configuration(), currentTarget.GardenName(), sshPublicKey); err != nil {
 		return err
 	}
 
+	var bastion *operationsv1alpha1.Bastion
+
+	if reusedBastion != nil {
+		bastion, err = patchBastionIngress(ctx, gardenClient.RuntimeClient(), reusedBastion, policies)
+		if err != nil {
+			return err
+		}
+	} else {
+		bastion, err = createOrPatchBastion(ctx, gardenClient.RuntimeClient(), bastionKey, shoot, sshPublicKey, policies)
+		if err != nil {
+			return err
+		}
+
+		if store != nil {
+			// remember the bastion and its keys, so that later commands of this session can reuse it
+			var privateKeyFile PrivateKeyFile
+			if o.GeneratedSSHKeys {
+				privateKeyFile = o.SSHPrivateKeyFile
+			}
+
+			if _, _, err := store.save(bastionKey, sshPublicKey, privateKeyFile); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(o.BastionUserKnownHostsFiles) == 0 {
 		// Set the default known_hosts file for bastions if none is provided.
 		// Bastion host keys are stored in a temporary directory because they are
@@ -682,6 +1139,12 @@ func (o *SSHOptions) Run(f util.Factory) error {
 			return fmt.Errorf("failed to create directory for bastion known hosts file: %w", err)
 		}
 
+		// the temp directory is shared by all gardens, record the bastion to be able to prune the file later
+		owner := bastionOwner{Garden: currentTarget.GardenName(), Namespace: bastion.Namespace, Name: bastion.Name}
+		if err := writeBastionOwner(filepath.Dir(filepath.Dir(knownHostsFile)), owner); err != nil {
+			return err
+		}
+
 		o.BastionUserKnownHostsFiles = []string{knownHostsFile}
 		logger.Info("Using default known_hosts file for bastion", "knownHostsFile", knownHostsFile)
 	}
@@ -702,8 +1165,21 @@ func (o *SSHOptions) Run(f util.Factory) error {
 		logger.Info("Using default known_hosts file for shoot node", "knownHostsFile", knownHostsFile)
 	}
 
+	// re-detect the public IP addresses while keeping the bastion alive, unless the CIDRs have been provided by the user
+	var (
+		detectedCIDRs   []string
+		ingressPolicies func(ctx context.Context) ([]operationsv1alpha1.BastionIngressPolicy, error)
+	)
+
+	if o.AutoDetected {
+		detectedCIDRs = ingressCIDRs(policies)
+		ingressPolicies = func(ctx context.Context) ([]operationsv1alpha1.BastionIngressPolicy, error) {
+			return detectIngressPolicies(ctx, f, shoot.Spec.Provider.Type)
+		}
+	}
+
 	// continuously keep the bastion alive by renewing its annotation
-	go keepBastionAlive(ctx, cancel, gardenClient.RuntimeClient(), bastion.DeepCopy())
+	go keepBastionAlive(ctx, cancel, gardenClient.RuntimeClient(), bastion.DeepCopy(), detectedCIDRs, ingressPolicies)
 
 	logger.Info("Waiting for bastion to be ready…", "waitTimeout", o.WaitTimeout)
 
@@ -716,15 +1192,75 @@ func (o *SSHOptions) Run(f util.Factory) error {
 
 	logger.Info("Bastion host became available.", "address", toAddress(bastion.Status.Ingress).String())
 
-	bastionPreferredAddress := preferredBastionAddress(o.BastionHost, bastion)
+	return connect(ctx, &bastionConnection{
+		manager:             manager,
+		target:              currentTarget,
+		shootClient:         shootClient,
+		bastion:             bastion,
+		bastionAddress:      preferredBastionAddress(o.BastionHost, bastion),
+		nodeHostname:        nodeHostname,
+		nodePrivateKeyFiles: nodePrivateKeyFiles,
+		gardenClient:        gardenClient,
+		gardenHomeDir:       f.GardenHomeDir(),
+		accessRestrictions:  ts.accessRestrictions,
+	})
+}
+
+// selectsNode returns true if the node is selected by worker pool, zone or label selector instead of its name.
+func (o *SSHOptions) selectsNode() bool {
+	return o.Random || !o.NodeSelection.isEmpty()
+}
+
+// selectNode sets the NodeName to a ready node matching the NodeSelection, unless a node name has been given.
+// If several nodes match, a random node is selected or the user is asked to choose one.
+func (o *SSHOptions) selectNode(ctx context.Context, shootClient client.Client) error {
+	if o.NodeName != "" || !o.selectsNode() {
+		return nil
+	}
+
+	logger := klog.FromContext(ctx)
+
+	names, err := o.readyNodeNames(ctx, shootClient)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(names) == 0:
+		return errors.New("no ready node matches the selection")
+	case len(names) == 1:
+		o.NodeName = names[0]
+	case o.Random:
+		o.NodeName = names[mathrand.IntN(len(names))] //nolint:gosec // the node does not need to be selected with a cryptographically secure random number
+	default:
+		name, err := pick(o.IOStreams, "node> ", names)
+		if errors.Is(err, util.ErrNoTerminal) {
+			return fmt.Errorf("%d ready nodes match the selection, use --random or a more specific selection: %w", len(names), err)
+		} else if err != nil {
+			return err
+		}
+
+		o.NodeName = name
+	}
+
+	logger.Info("Selected node", "nodeName", o.NodeName, "matchingNodes", len(names))
+
+	return nil
+}
+
+// connect prints the connection information or opens an SSH connection to the node.
+func (o *SSHOptions) connect(ctx context.Context, conn *bastionConnection) error {
+	logger := klog.FromContext(ctx)
 
 	if !o.Interactive {
 		var nodes []corev1.Node
 
 		var pendingNodeNames []string
 
-		if nodeHostname == "" {
-			nodes, err = getNodes(ctx, shootClient)
+		var err error
+
+		if conn.nodeHostname == "" || o.EmitSSHConfig {
+			nodes, err = getNodes(ctx, conn.shootClient)
 			if err != nil {
 				return fmt.Errorf("failed to list shoot cluster nodes: %w", err)
 			}
@@ -733,24 +1269,25 @@ func (o *SSHOptions) Run(f util.Factory) error {
 			// This is an optional step, as only Gardener operators have access to the Seeds.
 			// Regular users will receive a 'Forbidden' error when trying to fetch the Machines.
 			// However, we do not want to log an error message in this case.
-			pendingNodeNames, err = getNodeNamesFromMachines(ctx, manager, currentTarget)
+			pendingNodeNames, err = getNodeNamesFromMachines(ctx, conn.manager, conn.target)
 			if err != nil && !apierrors.IsForbidden(err) {
 				logger.Info("failed to get shoot cluster node names from machines", "err", err)
 			}
 		}
 
 		connectInformation, err := NewConnectInformation(
-			bastion,
-			bastionPreferredAddress,
+			conn.bastion,
+			conn.bastionAddress,
 			o.BastionPort,
 			o.BastionUserKnownHostsFiles,
 			o.BastionStrictHostKeyChecking,
 			o.NodeUserKnownHostsFiles,
 			o.NodeStrictHostKeyChecking,
-			nodeHostname,
+			conn.nodeHostname,
 			o.SSHPublicKeyFile,
 			o.SSHPrivateKeyFile,
-			nodePrivateKeyFiles,
+			o.SSHCertificateFile,
+			conn.nodePrivateKeyFiles,
 			nodes,
 			pendingNodeNames,
 			o.User,
@@ -759,6 +1296,15 @@ func (o *SSHOptions) Run(f util.Factory) error {
 			return err
 		}
 
+		if o.EmitSSHConfig {
+			o.SSHConfigFile = sshConfigFilePath(conn.manager.SessionDir(), client.ObjectKeyFromObject(conn.bastion))
+			if err := writeSSHConfig(o.SSHConfigFile, connectInformation); err != nil {
+				return err
+			}
+
+			connectInformation.SSHConfigFile = o.SSHConfigFile
+		}
+
 		if err := o.PrintObject(connectInformation); err != nil {
 			return err
 		}
@@ -772,19 +1318,49 @@ func (o *SSHOptions) Run(f util.Factory) error {
 		return nil
 	}
 
+	ioStreams := o.IOStreams
+
+	if o.Record {
+		recorder, err := o.startSessionRecording(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				logger.Error(err, "Failed to close session recording")
+			}
+		}()
+
+		ioStreams.Out = io.MultiWriter(o.IOStreams.Out, recorder)
+		ioStreams.ErrOut = io.MultiWriter(o.IOStreams.ErrOut, recorder)
+	}
+
+	if o.Client == SSHClientNative {
+		nativeConn, err := o.nativeConnectionFor(conn)
+		if err != nil {
+			return err
+		}
+
+		return nativeRemoteShell(ctx, ioStreams, nativeConn)
+	}
+
 	return remoteShell(
 		ctx,
-		o.IOStreams,
-		bastionPreferredAddress,
+		ioStreams,
+		conn.bastionAddress,
 		o.BastionPort,
 		o.SSHPrivateKeyFile,
+		o.SSHCertificateFile,
 		o.BastionUserKnownHostsFiles,
 		o.BastionStrictHostKeyChecking,
 		o.NodeUserKnownHostsFiles,
 		o.NodeStrictHostKeyChecking,
-		nodeHostname,
-		nodePrivateKeyFiles,
+		conn.nodeHostname,
+		conn.nodePrivateKeyFiles,
 		o.User,
+		o.PortForwards,
+		o.ForwardOnly,
 	)
 }
 
@@ -821,10 +1397,120 @@ func createOrPatchBastion(ctx context.Context, gardenClient client.Client, key c
 	return bastion, nil
 }
 
+// findReusableBastion returns the most recently created ready bastion of the current user for the shoot, which
+// has been created within the current session and can be accessed with the SSH keys of the options or the stored
+// generated keys. If the stored keys are used, the generated SSH keys of the options are replaced with them.
+// Nil is returned if there is no such bastion.
+func (o *SSHOptions) findReusableBastion(ctx context.Context, gardenClient clientgarden.Client, store *bastionStore, shoot *gardencorev1beta1.Shoot) (*operationsv1alpha1.Bastion, error) {
+	logger := klog.FromContext(ctx)
+
+	user, err := gardenClient.CurrentUser(ctx)
+	if err != nil {
+		logger.V(1).Info("Could not determine the current user, not reusing a bastion", "err", err)
+		return nil, nil
+	}
+
+	bastionList, err := gardenClient.ListBastions(ctx, clientgarden.ProjectFilter{
+		"metadata.namespace": shoot.Namespace,
+		"spec.shootRef.name": shoot.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := os.ReadFile(o.SSHPublicKeyFile.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH public key: %w", err)
+	}
+
+	bastions := bastionList.Items
+	sort.Slice(bastions, func(i, j int) bool {
+		return bastions[j].CreationTimestamp.Before(&bastions[i].CreationTimestamp)
+	})
+
+	for i := range bastions {
+		bastion := &bastions[i]
+
+		if bastion.Annotations[corev1beta1constants.GardenCreatedBy] != user || bastion.DeletionTimestamp != nil {
+			continue
+		}
+
+		if cond := corev1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
+			continue
+		}
+
+		if bastion.Status.Ingress == nil || (bastion.Status.ExpirationTimestamp != nil && !bastion.Status.ExpirationTimestamp.After(time.Now())) {
+			continue
+		}
+
+		storedPublicKey, storedPrivateKeyFile, storedPublicKeyFile, err := store.load(client.ObjectKeyFromObject(bastion))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue // not created within this session
+		} else if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(string(storedPublicKey)) != strings.TrimSpace(bastion.Spec.SSHPublicKey) {
+			continue
+		}
+
+		switch {
+		case o.GeneratedSSHKeys && storedPrivateKeyFile != "":
+			// the keypair generated for a new bastion is not needed
+			removeGeneratedSSHKeys(logger, o)
+
+			o.SSHPublicKeyFile = storedPublicKeyFile
+			o.SSHPrivateKeyFile = storedPrivateKeyFile
+			o.GeneratedSSHKeys = false
+		case !o.GeneratedSSHKeys && strings.TrimSpace(string(publicKey)) == strings.TrimSpace(string(storedPublicKey)):
+			// the bastion has been created with the SSH keys provided by the user
+		default:
+			continue
+		}
+
+		logger.Info("Reusing bastion", "bastion", klog.KObj(bastion))
+
+		return bastion, nil
+	}
+
+	return nil, nil
+}
+
+// patchBastionIngress adds the given ingress policies to the reused bastion, if they are not yet allowed.
+func patchBastionIngress(ctx context.Context, gardenClient client.Client, bastion *operationsv1alpha1.Bastion, policies []operationsv1alpha1.BastionIngressPolicy) (*operationsv1alpha1.Bastion, error) {
+	oldBastion := bastion.DeepCopy()
+
+	for _, policy := range policies {
+		if !slices.ContainsFunc(bastion.Spec.Ingress, func(p operationsv1alpha1.BastionIngressPolicy) bool {
+			return p.IPBlock.CIDR == policy.IPBlock.CIDR
+		}) {
+			bastion.Spec.Ingress = append(bastion.Spec.Ingress, policy)
+		}
+	}
+
+	if len(bastion.Spec.Ingress) == len(oldBastion.Spec.Ingress) {
+		return bastion, nil
+	}
+
+	if err := gardenClient.Patch(ctx, bastion, client.MergeFrom(oldBastion)); err != nil {
+		return nil, fmt.Errorf("failed to patch bastion ingress: %w", err)
+	}
+
+	klog.FromContext(ctx).Info("Added ingress policies to reused bastion", "bastion", klog.KObj(bastion), "policies", len(bastion.Spec.Ingress)-len(oldBastion.Spec.Ingress))
+
+	return bastion, nil
+}
+
 func (o *SSHOptions) bastionIngressPolicies(logger klog.Logger, providerType string) ([]operationsv1alpha1.BastionIngressPolicy, error) {
+	return ingressPoliciesForCIDRs(logger, providerType, o.CIDRs, o.AutoDetected)
+}
+
+// ingressPoliciesForCIDRs returns the bastion ingress policies for the given CIDRs. IPv6 CIDRs are skipped for GCP
+// if they have been auto-detected, otherwise an error is returned.
+func ingressPoliciesForCIDRs(logger klog.Logger, providerType string, cidrs []string, autoDetected bool) ([]operationsv1alpha1.BastionIngressPolicy, error) {
 	var policies []operationsv1alpha1.BastionIngressPolicy
 
-	for _, cidr := range o.CIDRs {
+	for _, cidr := range cidrs {
 		if providerType == "gcp" {
 			ip, _, err := net.ParseCIDR(cidr)
 			if err != nil {
@@ -832,7 +1518,7 @@ func (o *SSHOptions) bastionIngressPolicies(logger klog.Logger, providerType str
 			}
 
 			if ip.To4() == nil {
-				if !o.AutoDetected {
+				if !autoDetected {
 					return nil, fmt.Errorf("GCP only supports IPv4: %s", cidr)
 				}
 
@@ -869,29 +1555,58 @@ func printTargetInformation(logger klog.Logger, t target.Target) {
 	logger.Info("Preparing SSH access", "target", target, "garden", t.GardenName())
 }
 
-func cleanup(ctx context.Context, o *SSHOptions, gardenClient client.Client, bastionKey client.ObjectKey, nodePrivateKeyFiles []PrivateKeyFile) {
+// cleanup deletes the bastion and the temporary SSH keys, unless the bastion should be kept. If the bastion is tracked
+// in the bastion store, the lease is released and the bastion is only deleted if no other command is still using it.
+func cleanup(ctx context.Context, o *SSHOptions, gardenClient client.Client, bastionKey client.ObjectKey, nodePrivateKeyFiles []PrivateKeyFile, store *bastionStore, lease *bastionLease) {
 	logger := klog.FromContext(ctx)
 
+	inUse := false
+
+	if lease != nil {
+		active, err := lease.release(time.Now(), bastionLeaseTTL())
+		if err != nil {
+			logger.Error(err, "Failed to release bastion lease", "bastion", klog.KRef(bastionKey.Namespace, bastionKey.Name))
+		}
+
+		inUse = active > 0
+	}
+
 	if !o.KeepBastion {
 		logger.Info("Cleaning up")
 
-		bastion := &operationsv1alpha1.Bastion{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      bastionKey.Name,
-				Namespace: bastionKey.Namespace,
-			},
-		}
-		if err := gardenClient.Delete(ctx, bastion); client.IgnoreNotFound(err) != nil {
-			logger.Error(err, "Failed to delete bastion.", "bastion", klog.KObj(bastion))
+		if inUse {
+			logger.Info("Not deleting bastion, it is still used by other commands of this session", "bastion", klog.KRef(bastionKey.Namespace, bastionKey.Name))
+		} else {
+			bastion := &operationsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      bastionKey.Name,
+					Namespace: bastionKey.Namespace,
+				},
+			}
+			if err := gardenClient.Delete(ctx, bastion); client.IgnoreNotFound(err) != nil {
+				logger.Error(err, "Failed to delete bastion.", "bastion", klog.KObj(bastion))
+			}
+
+			if store != nil {
+				if err := store.remove(bastionKey); err != nil {
+					logger.Error(err, "Failed to remove bastion from store", "bastion", klog.KObj(bastion))
+				}
+			}
+
+			if o.SSHConfigFile != "" {
+				if err := os.Remove(o.SSHConfigFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					logger.Error(err, "Failed to delete SSH config file", "path", o.SSHConfigFile)
+				}
+			}
 		}
 
 		if o.GeneratedSSHKeys {
-			if err := os.Remove(o.SSHPublicKeyFile.String()); err != nil {
-				logger.Error(err, "Failed to delete SSH public key file", "path", o.SSHPublicKeyFile)
-			}
+			removeGeneratedSSHKeys(logger, o)
+		}
 
-			if err := os.Remove(o.SSHPrivateKeyFile.String()); err != nil {
-				logger.Error(err, "Failed to delete SSH private key file", "path", o.SSHPrivateKeyFile)
+		if o.SSHCertificateFile != "" {
+			if err := os.Remove(o.SSHCertificateFile); err != nil {
+				logger.Error(err, "Failed to delete SSH certificate file", "path", o.SSHCertificateFile)
 			}
 		}
 
@@ -910,17 +1625,31 @@ func cleanup(ctx context.Context, o *SSHOptions, gardenClient client.Client, bas
 			logger.Info("The SSH keypair for the bastion remain on disk", "publicKeyPath", o.SSHPublicKeyFile, "privateKeyPath", o.SSHPrivateKeyFile)
 		}
 
+		if o.SSHCertificateFile != "" {
+			logger.Info("The SSH certificate remains on disk", "path", o.SSHCertificateFile)
+		}
+
 		logger.Info("The private SSH keys for shoot nodes remain on disk", "paths", nodePrivateKeyFiles)
+
+		if o.SSHConfigFile != "" {
+			logger.Info("The SSH config file remains on disk", "path", o.SSHConfigFile)
+		}
 	}
 }
 
-func getNodeNamesFromMachinesOrNodes(ctx context.Context, manager target.Manager) ([]string, error) {
-	logger := klog.FromContext(ctx)
+// removeGeneratedSSHKeys removes the SSH keypair generated for the bastion.
+func removeGeneratedSSHKeys(logger klog.Logger, o *SSHOptions) {
+	if err := os.Remove(o.SSHPublicKeyFile.String()); err != nil {
+		logger.Error(err, "Failed to delete SSH public key file", "path", o.SSHPublicKeyFile)
+	}
 
-	currentTarget, err := manager.CurrentTarget()
-	if err != nil {
-		return nil, err
+	if err := os.Remove(o.SSHPrivateKeyFile.String()); err != nil {
+		logger.Error(err, "Failed to delete SSH private key file", "path", o.SSHPrivateKeyFile)
 	}
+}
+
+func getNodeNamesFromMachinesOrNodes(ctx context.Context, manager target.Manager, currentTarget target.Target) ([]string, error) {
+	logger := klog.FromContext(ctx)
 
 	if currentTarget.ShootName() == "" {
 		return nil, errors.New("no Shoot cluster targeted")
@@ -1099,6 +1828,7 @@ func remoteShell(
 	bastionHost string,
 	bastionPort string,
 	sshPrivateKeyFile PrivateKeyFile,
+	sshCertificateFile string,
 	bastionUserKnownHostsFiles []string,
 	bastionStrictHostKeyChecking StrictHostKeyChecking,
 	nodeUserKnownHostsFiles []string,
@@ -1106,11 +1836,14 @@ func remoteShell(
 	nodeHostname string,
 	nodePrivateKeyFiles []PrivateKeyFile,
 	user string,
+	portForwards []PortForward,
+	forwardOnly bool,
 ) error {
 	commandArgs := sshCommandArguments(
 		bastionHost,
 		bastionPort,
 		sshPrivateKeyFile,
+		sshCertificateFile,
 		bastionUserKnownHostsFiles,
 		bastionStrictHostKeyChecking,
 		nodeUserKnownHostsFiles,
@@ -1118,10 +1851,41 @@ func remoteShell(
 		nodeHostname,
 		nodePrivateKeyFiles,
 		user,
+		portForwards,
+		forwardOnly,
+	)
+
+	// additional sessions must not forward the same ports again
+	additionalSessionArgs := sshCommandArguments(
+		bastionHost,
+		bastionPort,
+		sshPrivateKeyFile,
+		sshCertificateFile,
+		bastionUserKnownHostsFiles,
+		bastionStrictHostKeyChecking,
+		nodeUserKnownHostsFiles,
+		nodeStrictHostKeyChecking,
+		nodeHostname,
+		nodePrivateKeyFiles,
+		user,
+		nil,
+		false,
 	)
 
 	fmt.Fprintf(ioStreams.Out, "> You can open additional SSH sessions by running the following command in a separate terminal:\n\n")
-	fmt.Fprintf(ioStreams.Out, "ssh %s\n\n", commandArgs.String())
+	fmt.Fprintf(ioStreams.Out, "ssh %s\n\n", additionalSessionArgs.String())
+
+	for _, forward := range portForwards {
+		if forward.Remote {
+			fmt.Fprintf(ioStreams.Out, "> Forwarding port %s on the node to %s\n", forward.listenAddress(), forward.targetAddress())
+		} else {
+			fmt.Fprintf(ioStreams.Out, "> Forwarding local port %s to %s on the node\n", forward.listenAddress(), forward.targetAddress())
+		}
+	}
+
+	if forwardOnly {
+		fmt.Fprintln(ioStreams.Out, "> Press Ctrl-C to stop forwarding, after which the bastion will be removed.")
+	}
 
 	var args []string
 
@@ -1139,7 +1903,40 @@ func getKeepAliveInterval() time.Duration {
 	return keepAliveInterval
 }
 
-func keepBastionAlive(ctx context.Context, cancel context.CancelFunc, gardenClient client.Client, bastion *operationsv1alpha1.Bastion) {
+// bastionLeaseTTL returns the duration after which a bastion lease that has not been renewed is considered expired.
+func bastionLeaseTTL() time.Duration {
+	return 3 * getKeepAliveInterval()
+}
+
+// keepBastionLeaseAlive renews the bastion lease until the context is cancelled.
+func keepBastionLeaseAlive(ctx context.Context, lease *bastionLease) {
+	logger := klog.FromContext(ctx)
+
+	ticker := time.NewTicker(getKeepAliveInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := lease.renew(time.Now()); err != nil {
+				logger.Error(err, "Failed to renew bastion lease")
+			}
+		}
+	}
+}
+
+// keepBastionAlive renews the keepalive annotation of the bastion until the context is cancelled. If ingressPolicies
+// is not nil, the detectedCIDRs in the ingress of the bastion are updated as well whenever the returned ingress policies change.
+func keepBastionAlive(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	gardenClient client.Client,
+	bastion *operationsv1alpha1.Bastion,
+	detectedCIDRs []string,
+	ingressPolicies func(ctx context.Context) ([]operationsv1alpha1.BastionIngressPolicy, error),
+) {
 	logger := klog.FromContext(ctx).WithValues("bastion", klog.KObj(bastion))
 
 	ticker := time.NewTicker(getKeepAliveInterval())
@@ -1179,6 +1976,10 @@ func keepBastionAlive(ctx context.Context, cancel context.CancelFunc, gardenClie
 
 			bastion.Annotations[corev1beta1constants.GardenerOperation] = corev1beta1constants.GardenerOperationKeepalive
 
+			if ingressPolicies != nil {
+				detectedCIDRs = refreshBastionIngress(ctx, logger, bastion, detectedCIDRs, ingressPolicies)
+			}
+
 			if err := gardenClient.Patch(ctx, bastion, client.MergeFrom(oldBastion)); err != nil {
 				logger.Error(err, "Failed to keep bastion alive.")
 			}
@@ -1186,6 +1987,76 @@ func keepBastionAlive(ctx context.Context, cancel context.CancelFunc, gardenClie
 	}
 }
 
+// refreshBastionIngress replaces the detectedCIDRs in the ingress of the bastion with the CIDRs of the policies returned
+// by ingressPolicies, if they differ. Other ingress policies, e.g. the ones added by other commands reusing the bastion,
+// are kept. The CIDRs that are allowed for this command afterwards are returned.
+func refreshBastionIngress(
+	ctx context.Context,
+	logger klog.Logger,
+	bastion *operationsv1alpha1.Bastion,
+	detectedCIDRs []string,
+	ingressPolicies func(ctx context.Context) ([]operationsv1alpha1.BastionIngressPolicy, error),
+) []string {
+	policies, err := ingressPolicies(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to detect public IP addresses, not updating the bastion ingress.")
+		return detectedCIDRs
+	}
+
+	newCIDRs := ingressCIDRs(policies)
+
+	if slices.Equal(detectedCIDRs, newCIDRs) {
+		return detectedCIDRs
+	}
+
+	logger.Info("Public IP addresses changed, updating bastion ingress.", "oldCIDRs", detectedCIDRs, "newCIDRs", newCIDRs)
+
+	bastion.Spec.Ingress = slices.DeleteFunc(bastion.Spec.Ingress, func(p operationsv1alpha1.BastionIngressPolicy) bool {
+		return slices.Contains(detectedCIDRs, p.IPBlock.CIDR) && !slices.Contains(newCIDRs, p.IPBlock.CIDR)
+	})
+
+	for _, policy := range policies {
+		if !slices.ContainsFunc(bastion.Spec.Ingress, func(p operationsv1alpha1.BastionIngressPolicy) bool {
+			return p.IPBlock.CIDR == policy.IPBlock.CIDR
+		}) {
+			bastion.Spec.Ingress = append(bastion.Spec.Ingress, policy)
+		}
+	}
+
+	return newCIDRs
+}
+
+// ingressCIDRs returns the sorted CIDRs of the ingress policies.
+func ingressCIDRs(policies []operationsv1alpha1.BastionIngressPolicy) []string {
+	cidrs := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		cidrs = append(cidrs, policy.IPBlock.CIDR)
+	}
+
+	sort.Strings(cidrs)
+
+	return cidrs
+}
+
+// detectIngressPolicies detects the public IP addresses of the system and returns the corresponding bastion ingress policies.
+func detectIngressPolicies(ctx context.Context, f util.Factory, providerType string) ([]operationsv1alpha1.BastionIngressPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	publicIPs, err := f.PublicIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrs := make([]string, 0, len(publicIPs))
+	for _, ip := range publicIPs {
+		cidrs = append(cidrs, ipToCIDR(ip))
+	}
+
+	// skipped IPv6 CIDRs are only logged when the bastion is created, not on every refresh
+	return ingressPoliciesForCIDRs(klog.FromContext(ctx).V(4), providerType, cidrs, true)
+}
+
 func getShootNodePrivateKeys(ctx context.Context, gardenClient client.Client, shoot *gardencorev1beta1.Shoot) ([][]byte, error) {
 	keys := [][]byte{}
 
@@ -1266,23 +2137,27 @@ func getMachines(ctx context.Context, c client.Client, namespace string) ([]mach
 	return machineList.Items, nil
 }
 
-func (o *SSHOptions) checkAccessRestrictions(cfg *config.Config, gardenName string, tf target.TargetFlags, shoot *gardencorev1beta1.Shoot) (bool, error) {
+// checkAccessRestrictions shows the access restrictions of the shoot and asks for confirmation if required.
+// It returns the access restriction messages and false if the user did not confirm them.
+func (o *SSHOptions) checkAccessRestrictions(cfg *config.Config, gardenName string, tf target.TargetFlags, shoot *gardencorev1beta1.Shoot) (ac.AccessRestrictionMessages, bool, error) {
 	if cfg == nil {
-		return false, errors.New("garden configuration is required")
+		return nil, false, errors.New("garden configuration is required")
 	}
 
 	if tf == nil {
-		return false, errors.New("target flags are required")
+		return nil, false, errors.New("target flags are required")
 	}
 
 	// handle access restrictions
 	garden, err := cfg.Garden(gardenName)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	askForConfirmation := tf.ShootName() != "" && !o.ConfirmAccessRestriction
 	handler := ac.NewAccessRestrictionHandler(o.IOStreams.In, o.IOStreams.ErrOut, askForConfirmation) // do not write access restriction to stdout, otherwise it would break the output format
 
-	return handler(ac.CheckAccessRestrictions(garden.AccessRestrictions, shoot)), nil
+	messages := ac.CheckAccessRestrictions(garden.AccessRestrictions, shoot)
+
+	return messages, handler(messages), nil
 }
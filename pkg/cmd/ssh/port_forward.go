@@ -0,0 +1,192 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// PortForward is a port forwarding specification in the format [bind_address:]port:host:hostport.
+// A local port forwarding (ssh -L) forwards connections to the port on the local machine to host:hostport
+// as seen from the shoot node. A remote port forwarding (ssh -R) forwards connections to the port on the
+// shoot node to host:hostport as seen from the local machine.
+type PortForward struct {
+	// Remote is true for a remote port forwarding (ssh -R) and false for a local port forwarding (ssh -L)
+	Remote bool `json:"remote"`
+	// BindAddress is the optional address the listening port is bound to
+	BindAddress string `json:"bindAddress,omitempty"`
+	// Port is the listening port
+	Port int `json:"port"`
+	// Host is the host connections are forwarded to
+	Host string `json:"host"`
+	// HostPort is the port on Host connections are forwarded to
+	HostPort int `json:"hostPort"`
+}
+
+var _ fmt.Stringer = PortForward{}
+
+// ParsePortForward parses a port forwarding specification in the format [bind_address:]port:host:hostport.
+// IPv6 addresses must be enclosed in square brackets.
+func ParsePortForward(spec string, remote bool) (PortForward, error) {
+	fields, err := splitPortForwardSpec(spec)
+	if err != nil {
+		return PortForward{}, fmt.Errorf("invalid port forwarding %q: %w", spec, err)
+	}
+
+	forward := PortForward{Remote: remote}
+
+	switch len(fields) {
+	case 3:
+	case 4:
+		forward.BindAddress = fields[0]
+		fields = fields[1:]
+	default:
+		return PortForward{}, fmt.Errorf("invalid port forwarding %q: expected format [bind_address:]port:host:hostport", spec)
+	}
+
+	if forward.Port, err = parsePort(fields[0]); err != nil {
+		return PortForward{}, fmt.Errorf("invalid port forwarding %q: %w", spec, err)
+	}
+
+	forward.Host = fields[1]
+	if forward.Host == "" {
+		return PortForward{}, fmt.Errorf("invalid port forwarding %q: host must not be empty", spec)
+	}
+
+	if forward.HostPort, err = parsePort(fields[2]); err != nil {
+		return PortForward{}, fmt.Errorf("invalid port forwarding %q: %w", spec, err)
+	}
+
+	return forward, nil
+}
+
+// String returns the port forwarding specification in the format [bind_address:]port:host:hostport.
+func (p PortForward) String() string {
+	spec := fmt.Sprintf("%d:%s:%d", p.Port, bracketIPv6(p.Host), p.HostPort)
+	if p.BindAddress != "" {
+		spec = bracketIPv6(p.BindAddress) + ":" + spec
+	}
+
+	return spec
+}
+
+// listenAddress returns the address of the listening port. Without a bind address, ssh listens on the loopback interface.
+func (p PortForward) listenAddress() string {
+	host := p.BindAddress
+	if host == "" {
+		host = "localhost"
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(p.Port))
+}
+
+// targetAddress returns the address connections are forwarded to.
+func (p PortForward) targetAddress() string {
+	return net.JoinHostPort(p.Host, strconv.Itoa(p.HostPort))
+}
+
+// argument returns the ssh client argument for the port forwarding.
+func (p PortForward) argument() argument {
+	flag := "-L"
+	if p.Remote {
+		flag = "-R"
+	}
+
+	return argument{value: flag + p.String()}
+}
+
+// splitPortForwardSpec splits the specification at colons that are not enclosed in square brackets.
+// The brackets are removed from the returned fields.
+func splitPortForwardSpec(spec string) ([]string, error) {
+	var (
+		fields    []string
+		field     strings.Builder
+		bracketed bool
+	)
+
+	for _, r := range spec {
+		switch {
+		case r == '[' && !bracketed && field.Len() == 0:
+			bracketed = true
+		case r == ']' && bracketed:
+			bracketed = false
+		case r == ':' && !bracketed:
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteRune(r)
+		}
+	}
+
+	if bracketed {
+		return nil, errors.New("missing closing bracket")
+	}
+
+	return append(fields, field.String()), nil
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", value)
+	}
+
+	return port, nil
+}
+
+func bracketIPv6(host string) string {
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+
+	return host
+}
+
+// portForwardsValue is a repeatable flag that appends local or remote port forwardings to a list.
+type portForwardsValue struct {
+	forwards *[]PortForward
+	remote   bool
+}
+
+var _ pflag.Value = (*portForwardsValue)(nil)
+
+func newPortForwardsValue(forwards *[]PortForward, remote bool) *portForwardsValue {
+	return &portForwardsValue{forwards: forwards, remote: remote}
+}
+
+func (v *portForwardsValue) Set(value string) error {
+	forward, err := ParsePortForward(value, v.remote)
+	if err != nil {
+		return err
+	}
+
+	*v.forwards = append(*v.forwards, forward)
+
+	return nil
+}
+
+func (v *portForwardsValue) Type() string {
+	return "stringArray"
+}
+
+func (v *portForwardsValue) String() string {
+	var specs []string
+
+	for _, forward := range *v.forwards {
+		if forward.Remote == v.remote {
+			specs = append(specs, forward.String())
+		}
+	}
+
+	return "[" + strings.Join(specs, ",") + "]"
+}
@@ -0,0 +1,46 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardenctl-v2/pkg/cmd/ssh"
+)
+
+var _ = Describe("PortForward", func() {
+	DescribeTable("ParsePortForward should parse valid specifications",
+		func(spec string, remote bool, expected ssh.PortForward, expectedString string) {
+			forward, err := ssh.ParsePortForward(spec, remote)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forward).To(Equal(expected))
+			Expect(forward.String()).To(Equal(expectedString))
+		},
+		Entry("local forwarding", "8080:localhost:10250", false,
+			ssh.PortForward{Port: 8080, Host: "localhost", HostPort: 10250}, "8080:localhost:10250"),
+		Entry("remote forwarding", "9090:127.0.0.1:3000", true,
+			ssh.PortForward{Remote: true, Port: 9090, Host: "127.0.0.1", HostPort: 3000}, "9090:127.0.0.1:3000"),
+		Entry("with bind address", "0.0.0.0:8080:localhost:10250", false,
+			ssh.PortForward{BindAddress: "0.0.0.0", Port: 8080, Host: "localhost", HostPort: 10250}, "0.0.0.0:8080:localhost:10250"),
+		Entry("with IPv6 addresses", "[::1]:8080:[fd00::1]:10250", false,
+			ssh.PortForward{BindAddress: "::1", Port: 8080, Host: "fd00::1", HostPort: 10250}, "[::1]:8080:[fd00::1]:10250"),
+	)
+
+	DescribeTable("ParsePortForward should reject invalid specifications",
+		func(spec string, expectedErr string) {
+			_, err := ssh.ParsePortForward(spec, false)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("missing host port", "8080:localhost", "expected format [bind_address:]port:host:hostport"),
+		Entry("too many fields", "a:8080:localhost:10250:1", "expected format [bind_address:]port:host:hostport"),
+		Entry("invalid port", "http:localhost:10250", `invalid port "http"`),
+		Entry("port out of range", "8080:localhost:65536", `invalid port "65536"`),
+		Entry("empty host", "8080::10250", "host must not be empty"),
+		Entry("unclosed bracket", "8080:[::1:10250", "missing closing bracket"),
+	)
+})
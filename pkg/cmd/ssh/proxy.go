@@ -0,0 +1,179 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/env"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// NewCmdSSHProxy returns a new ssh proxy command.
+func NewCmdSSHProxy(f util.Factory, o *SSHProxyOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy",
+		Short: "Open a local SOCKS5 proxy into the network of a Shoot cluster",
+		Long: `Open a local SOCKS5 proxy that forwards connections through the bastion into the network of a Shoot cluster.
+This allows to reach endpoints that are only accessible from within the network of the Shoot cluster, e.g. internal load balancers or private API endpoints.
+
+A bastion is created for the proxy and is automatically cleaned up when the proxy is stopped.
+The environment variables HTTPS_PROXY and ALL_PROXY that configure clients to use the proxy are printed for the given shell.
+They use the socks5h scheme, so hostnames are resolved through the proxy within the network of the Shoot cluster.`,
+		Example: `# Open a SOCKS5 proxy on the default port 1080
+gardenctl ssh proxy
+
+# Open the proxy on port 9050 and print the environment variables for fish
+gardenctl ssh proxy --port 9050 --shell fish`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionFuncsForStrictHostKeyCheckings(cmd)
+	registerCompletionFuncForKeyType(cmd)
+	utilruntime.Must(cmd.RegisterFlagCompletionFunc("shell", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var shells []string
+		for _, shell := range env.ValidShells() {
+			shells = append(shells, string(shell))
+		}
+
+		return shells, cobra.ShellCompDirectiveNoFileComp
+	}))
+
+	o.AccessConfig.AddFlags(cmd.Flags())
+	RegisterCompletionFuncsForAccessConfigFlags(cmd, f)
+
+	f.TargetFlags().AddFlags(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, o.IOStreams, cmd.Flags())
+
+	return cmd
+}
+
+// SSHProxyOptions contains all the configurable options for the ssh proxy command.
+type SSHProxyOptions struct {
+	SSHOptions
+
+	// Port is the local port of the SOCKS5 proxy
+	Port int
+
+	// BindAddress is the local address the SOCKS5 proxy is bound to
+	BindAddress string
+
+	// Shell is the shell for which the environment variables are printed
+	Shell string
+
+	// Template is the template for printing the environment variables
+	Template env.Template
+}
+
+// NewSSHProxyOptions returns initialized SSHProxyOptions.
+func NewSSHProxyOptions(ioStreams util.IOStreams) *SSHProxyOptions {
+	o := &SSHProxyOptions{
+		SSHOptions:  *NewSSHOptions(ioStreams),
+		Port:        1080,
+		BindAddress: "127.0.0.1",
+		Shell:       "bash",
+	}
+
+	// the proxy does not connect to a node
+	o.Interactive = false
+
+	return o
+}
+
+// AddFlags adds command-line flags to the flag set.
+func (o *SSHProxyOptions) AddFlags(flagSet *pflag.FlagSet) {
+	flagSet.IntVar(&o.Port, "port", o.Port, "Local port of the SOCKS5 proxy.")
+	flagSet.StringVar(&o.BindAddress, "bind-address", o.BindAddress, "Local address the SOCKS5 proxy is bound to.")
+	flagSet.StringVar(&o.Shell, "shell", o.Shell, fmt.Sprintf("Shell for which the proxy environment variables are printed. One of %v.", env.ValidShells()))
+	o.addBastionFlags(flagSet)
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *SSHProxyOptions) Complete(f util.Factory, cmd *cobra.Command, _ []string) error {
+	o.Template = env.NewTemplate()
+
+	filename := filepath.Join(f.GardenHomeDir(), "templates", "proxy.tmpl")
+	if err := o.Template.ParseFiles(filename); err != nil {
+		return err
+	}
+
+	return o.SSHOptions.Complete(f, cmd, nil)
+}
+
+// Validate validates the provided SSHProxyOptions.
+func (o *SSHProxyOptions) Validate() error {
+	if err := env.Shell(o.Shell).Validate(); err != nil {
+		return err
+	}
+
+	if _, err := parsePort(strconv.Itoa(o.Port)); err != nil {
+		return fmt.Errorf("invalid proxy port: %w", err)
+	}
+
+	if o.BindAddress == "" {
+		return errors.New("the bind address of the proxy must not be empty")
+	}
+
+	return o.SSHOptions.Validate()
+}
+
+// Run executes the command.
+func (o *SSHProxyOptions) Run(f util.Factory) error {
+	// the session cannot be recorded, as no interactive shell is opened
+	if err := o.enforceSessionRecording(f, false); err != nil {
+		return err
+	}
+
+	return o.runWithBastion(f, nil, func(ctx context.Context, conn *bastionConnection) error {
+		listenAddress := net.JoinHostPort(o.BindAddress, strconv.Itoa(o.Port))
+
+		fmt.Fprintf(o.IOStreams.Out, "> Opening SOCKS5 proxy on %s through the bastion.\n", listenAddress)
+		fmt.Fprintf(o.IOStreams.Out, "> Run the following commands in a separate terminal to use the proxy:\n\n")
+
+		// socks5h makes clients resolve hostnames through the proxy, so that internal DNS names of the shoot network can be reached
+		data := map[string]interface{}{
+			"proxyURL": "socks5h://" + listenAddress,
+		}
+
+		if err := o.Template.ExecuteTemplate(o.IOStreams.Out, o.Shell, data); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(o.IOStreams.Out, "\n> Press Ctrl-C to stop the proxy, after which the bastion will be removed.")
+
+		commandArgs := socksProxyArguments(
+			conn.bastionAddress,
+			o.BastionPort,
+			o.SSHPrivateKeyFile,
+			o.SSHCertificateFile,
+			o.BastionUserKnownHostsFiles,
+			o.BastionStrictHostKeyChecking,
+			listenAddress,
+		)
+
+		var args []string
+
+		for _, arg := range commandArgs.list {
+			args = append(args, arg.value)
+		}
+
+		return execCommand(ctx, "ssh", args, o.IOStreams)
+	})
+}
@@ -0,0 +1,232 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+	"k8s.io/klog/v2"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+// sessionRecordingDirectoryName is the name of the directory below the garden home directory that contains the session recordings.
+const sessionRecordingDirectoryName = "recordings"
+
+// sessionRecordingMetadata describes the recorded SSH session. It is stored in the header of the asciicast file.
+type sessionRecordingMetadata struct {
+	// Target is the targeted shoot cluster
+	Target sessionRecordingTarget `json:"target"`
+	// Node is the name of the node
+	Node string `json:"node"`
+	// Bastion is the namespace and name of the bastion
+	Bastion string `json:"bastion"`
+	// User is the garden cluster user that opened the session
+	User string `json:"user"`
+	// AccessRestrictions are the access restrictions of the shoot cluster that have been shown to and confirmed by the user
+	AccessRestrictions []sessionRecordingAccessRestriction `json:"accessRestrictions,omitempty"`
+}
+
+// sessionRecordingTarget is the targeted shoot cluster of a recorded SSH session.
+type sessionRecordingTarget struct {
+	// Garden is the name of the garden
+	Garden string `json:"garden"`
+	// Project is the name of the project
+	Project string `json:"project,omitempty"`
+	// Shoot is the name of the shoot cluster
+	Shoot string `json:"shoot"`
+}
+
+// sessionRecordingAccessRestriction is a confirmed access restriction of a recorded SSH session.
+type sessionRecordingAccessRestriction struct {
+	// Message is the notification text of the access restriction
+	Message string `json:"message"`
+	// Options are the notification texts of the matching access restriction options
+	Options []string `json:"options,omitempty"`
+}
+
+// asciicastHeader is the header line of an asciicast v2 file, see https://docs.asciinema.org/manual/asciicast/v2/.
+type asciicastHeader struct {
+	Version   int                       `json:"version"`
+	Width     int                       `json:"width"`
+	Height    int                       `json:"height"`
+	Timestamp int64                     `json:"timestamp"`
+	Title     string                    `json:"title,omitempty"`
+	Env       map[string]string         `json:"env,omitempty"`
+	Metadata  *sessionRecordingMetadata `json:"gardenctl,omitempty"`
+}
+
+// sessionRecorder writes the output of an SSH session as asciicast v2 output events.
+// Input is not recorded, as it could contain secrets that are typed without being echoed.
+type sessionRecorder struct {
+	mutex sync.Mutex
+	file  *os.File
+	start time.Time
+	// pending is an incomplete UTF-8 sequence at the end of the last write
+	pending []byte
+}
+
+var _ io.Writer = &sessionRecorder{}
+
+// sessionRecordingFilePath returns the path of a new session recording within the given garden home directory.
+func sessionRecordingFilePath(gardenHomeDir string, start time.Time, metadata *sessionRecordingMetadata) string {
+	filename := fmt.Sprintf("%s--%s--%s--%s.cast", start.UTC().Format("20060102T150405Z"), metadata.Target.Project, metadata.Target.Shoot, metadata.Node)
+
+	return filepath.Join(gardenHomeDir, sessionRecordingDirectoryName, filename)
+}
+
+// newSessionRecorder creates the asciicast file and writes its header.
+func newSessionRecorder(path string, start time.Time, ioStreams util.IOStreams, metadata *sessionRecordingMetadata) (*sessionRecorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create directory for session recording: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session recording: %w", err)
+	}
+
+	width, height := terminalSize(ioStreams)
+
+	header := asciicastHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: start.Unix(),
+		Title:     fmt.Sprintf("gardenctl ssh %s", metadata.Node),
+		Env: map[string]string{
+			"SHELL": os.Getenv("SHELL"),
+			"TERM":  os.Getenv("TERM"),
+		},
+		Metadata: metadata,
+	}
+
+	data, err := json.Marshal(header)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to write session recording header: %w", err)
+	}
+
+	return &sessionRecorder{file: file, start: start}, nil
+}
+
+// Write records p as output event. It never fails, so that a failing recording does not break the SSH session.
+func (r *sessionRecorder) Write(p []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	data := append(r.pending, p...)
+
+	// keep an incomplete UTF-8 sequence at the end for the next write, as the event data must be valid UTF-8
+	n := len(data)
+
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				n = i
+			}
+
+			break
+		}
+	}
+
+	r.pending = append([]byte(nil), data[n:]...)
+
+	if n > 0 {
+		r.writeEvent(time.Since(r.start), data[:n])
+	}
+
+	return len(p), nil
+}
+
+// writeEvent writes an output event.
+func (r *sessionRecorder) writeEvent(elapsed time.Duration, data []byte) {
+	event, err := json.Marshal([]interface{}{elapsed.Seconds(), "o", string(data)})
+	if err != nil {
+		return
+	}
+
+	_, _ = r.file.Write(append(event, '\n'))
+}
+
+// Close writes pending output and closes the asciicast file.
+func (r *sessionRecorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if len(r.pending) > 0 {
+		r.writeEvent(time.Since(r.start), r.pending)
+		r.pending = nil
+	}
+
+	return r.file.Close()
+}
+
+// terminalSize returns the size of the terminal of the given streams, or 80x24 if they are not connected to a terminal.
+func terminalSize(ioStreams util.IOStreams) (int, int) {
+	for _, stream := range []interface{}{ioStreams.Out, ioStreams.In} {
+		if file, ok := stream.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+			if width, height, err := term.GetSize(int(file.Fd())); err == nil {
+				return width, height
+			}
+		}
+	}
+
+	return 80, 24
+}
+
+// startSessionRecording creates the session recording for the connection and prints its path.
+func (o *SSHOptions) startSessionRecording(ctx context.Context, conn *bastionConnection) (*sessionRecorder, error) {
+	user, err := conn.gardenClient.CurrentUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the current user for the session recording: %w", err)
+	}
+
+	metadata := &sessionRecordingMetadata{
+		Target: sessionRecordingTarget{
+			Garden:  conn.target.GardenName(),
+			Project: conn.target.ProjectName(),
+			Shoot:   conn.target.ShootName(),
+		},
+		Node:    o.NodeName,
+		Bastion: klog.KObj(conn.bastion).String(),
+		User:    user,
+	}
+
+	for _, message := range conn.accessRestrictions {
+		metadata.AccessRestrictions = append(metadata.AccessRestrictions, sessionRecordingAccessRestriction{
+			Message: message.Header,
+			Options: message.Items,
+		})
+	}
+
+	start := time.Now()
+	path := sessionRecordingFilePath(conn.gardenHomeDir, start, metadata)
+
+	recorder, err := newSessionRecorder(path, start, o.IOStreams, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "> Recording this session to %s\n", path)
+
+	return recorder, nil
+}
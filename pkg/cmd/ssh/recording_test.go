@@ -0,0 +1,109 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+var _ = Describe("sessionRecorder", func() {
+	var (
+		path     string
+		metadata *sessionRecordingMetadata
+		start    time.Time
+	)
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "recordings", "session.cast")
+		start = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		metadata = &sessionRecordingMetadata{
+			Target: sessionRecordingTarget{Garden: "garden", Project: "prod", Shoot: "shoot"},
+			Node:   "node-1",
+			User:   "user",
+		}
+	})
+
+	readEvents := func() []string {
+		content, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		var data []string
+
+		for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n")[1:] {
+			var event []interface{}
+			Expect(json.Unmarshal([]byte(line), &event)).To(Succeed())
+			Expect(event).To(HaveLen(3))
+			Expect(event[1]).To(Equal("o"))
+
+			data = append(data, event[2].(string))
+		}
+
+		return data
+	}
+
+	It("should name the recording after the start time, target and node", func() {
+		Expect(sessionRecordingFilePath("/home", start, metadata)).To(Equal(filepath.Join("/home", "recordings", "20250102T030405Z--prod--shoot--node-1.cast")))
+	})
+
+	It("should write an asciicast v2 header", func() {
+		streams, _, _, _ := util.NewTestIOStreams()
+
+		recorder, err := newSessionRecorder(path, start, streams, metadata)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+
+		content, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		header := asciicastHeader{}
+		Expect(json.Unmarshal(content, &header)).To(Succeed())
+		Expect(header.Version).To(Equal(2))
+		Expect(header.Width).To(Equal(80))
+		Expect(header.Height).To(Equal(24))
+		Expect(header.Timestamp).To(Equal(start.Unix()))
+		Expect(header.Metadata).To(Equal(metadata))
+	})
+
+	It("should not split multi-byte characters across events", func() {
+		streams, _, _, _ := util.NewTestIOStreams()
+
+		recorder, err := newSessionRecorder(path, start, streams, metadata)
+		Expect(err).NotTo(HaveOccurred())
+
+		euro := []byte("€")
+
+		_, err = recorder.Write(append([]byte("price: 5"), euro[:1]...))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = recorder.Write(euro[1:])
+		Expect(err).NotTo(HaveOccurred())
+		_, err = recorder.Write([]byte("\r\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+
+		Expect(readEvents()).To(Equal([]string{"price: 5", "€", "\r\n"}))
+	})
+
+	It("should not overwrite an existing recording", func() {
+		streams, _, _, _ := util.NewTestIOStreams()
+
+		recorder, err := newSessionRecorder(path, start, streams, metadata)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+
+		_, err = newSessionRecorder(path, start, streams, metadata)
+		Expect(err).To(MatchError(ContainSubstring("failed to create session recording")))
+	})
+})
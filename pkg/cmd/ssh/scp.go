@@ -0,0 +1,205 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// NewCmdSCP returns a new scp command.
+func NewCmdSCP(f util.Factory, o *SCPOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scp [-r] SOURCE... DESTINATION",
+		Short: "Copy files between the local machine and a node of a Shoot cluster",
+		Long: `Copy files between the local machine and a node of a Shoot cluster.
+
+Paths on the node are given in the format NODE_NAME:PATH. Relative paths on the node are relative to the home directory of the user.
+All paths on a node must refer to the same node.
+
+A bastion is created to access the node and is automatically cleaned up afterwards.`,
+		Example: `# Copy the kubelet logs from a node to the local machine
+gardenctl scp my-shoot-node-1:/var/log/kubelet.log .
+
+# Recursively copy a directory from the local machine to a node
+gardenctl scp -r ./scripts my-shoot-node-1:/tmp/scripts`,
+		Args: cobra.MinimumNArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			// remote paths cannot be completed
+			if parseSCPOperand(toComplete).isRemote() {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			// the shell only completes local files if no node names are returned, therefore node names are
+			// not offered for operands that can only be local paths
+			if isLocalSCPPath(toComplete) {
+				return nil, cobra.ShellCompDirectiveDefault
+			}
+
+			return nodeNameCompletions(f, toComplete, ":"), cobra.ShellCompDirectiveNoSpace
+		},
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionFuncsForStrictHostKeyCheckings(cmd)
+	registerCompletionFuncForKeyType(cmd)
+
+	o.AccessConfig.AddFlags(cmd.Flags())
+	RegisterCompletionFuncsForAccessConfigFlags(cmd, f)
+
+	f.TargetFlags().AddFlags(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, o.IOStreams, cmd.Flags())
+
+	return cmd
+}
+
+// SCPOptions contains all the configurable options for the scp command.
+type SCPOptions struct {
+	SSHOptions
+
+	// Recursive defines if directories are copied recursively
+	Recursive bool
+
+	// Operands are the source paths followed by the destination path
+	Operands []scpOperand
+}
+
+// NewSCPOptions returns initialized SCPOptions.
+func NewSCPOptions(ioStreams util.IOStreams) *SCPOptions {
+	return &SCPOptions{
+		SSHOptions: *NewSSHOptions(ioStreams),
+	}
+}
+
+// AddFlags adds command-line flags to the flag set.
+func (o *SCPOptions) AddFlags(flagSet *pflag.FlagSet) {
+	flagSet.BoolVarP(&o.Recursive, "recursive", "r", o.Recursive, "Recursively copy entire directories.")
+	o.addBastionFlags(flagSet)
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *SCPOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	o.Operands = nil
+
+	for _, arg := range args {
+		operand := parseSCPOperand(arg)
+		if operand.isRemote() && o.NodeName == "" {
+			o.NodeName = operand.node
+		}
+
+		o.Operands = append(o.Operands, operand)
+	}
+
+	return o.SSHOptions.Complete(f, cmd, nil)
+}
+
+// Validate validates the provided SCPOptions.
+func (o *SCPOptions) Validate() error {
+	if len(o.Operands) < 2 {
+		return errors.New("at least one source and a destination path are required")
+	}
+
+	if o.NodeName == "" {
+		return errors.New("at least one path must refer to a node in the format NODE_NAME:PATH")
+	}
+
+	for _, operand := range o.Operands {
+		if operand.isRemote() && operand.node != o.NodeName {
+			return fmt.Errorf("all paths must refer to the same node, got %q and %q", o.NodeName, operand.node)
+		}
+	}
+
+	return o.SSHOptions.Validate()
+}
+
+// Run executes the command.
+func (o *SCPOptions) Run(f util.Factory) error {
+	// the session cannot be recorded, as no interactive shell is opened
+	if err := o.enforceSessionRecording(f, false); err != nil {
+		return err
+	}
+
+	return o.runWithBastion(f, nil, func(ctx context.Context, conn *bastionConnection) error {
+		commandArgs := scpCommandArguments(
+			conn.bastionAddress,
+			o.BastionPort,
+			o.SSHPrivateKeyFile,
+			o.SSHCertificateFile,
+			o.BastionUserKnownHostsFiles,
+			o.BastionStrictHostKeyChecking,
+			o.NodeUserKnownHostsFiles,
+			o.NodeStrictHostKeyChecking,
+			conn.nodeHostname,
+			conn.nodePrivateKeyFiles,
+			o.User,
+			o.Recursive,
+			o.Operands,
+		)
+
+		var args []string
+
+		for _, arg := range commandArgs.list {
+			args = append(args, arg.value)
+		}
+
+		return execCommand(ctx, "scp", args, o.IOStreams)
+	})
+}
+
+// scpOperand is a path on the local machine or on a shoot node.
+type scpOperand struct {
+	// node is the name of the node, empty for a local path
+	node string
+	// path is the path on the local machine or the node
+	path string
+}
+
+// parseSCPOperand parses a path in the format [NODE_NAME:]PATH. Like scp, a colon is only treated as
+// separator if it is not preceded by a slash, so that local paths containing colons can be given as ./file:name.
+func parseSCPOperand(value string) scpOperand {
+	if filepath.VolumeName(value) != "" {
+		return scpOperand{path: value}
+	}
+
+	colon := strings.Index(value, ":")
+	slash := strings.Index(value, "/")
+
+	if colon > 0 && (slash < 0 || colon < slash) {
+		return scpOperand{node: value[:colon], path: value[colon+1:]}
+	}
+
+	return scpOperand{path: value}
+}
+
+// isLocalSCPPath returns true if the given operand cannot be the beginning of NODE_NAME:PATH, e.g. "./file", "/tmp" or "~/file".
+func isLocalSCPPath(value string) bool {
+	return strings.HasPrefix(value, ".") || strings.HasPrefix(value, "~") || strings.Contains(value, "/") || filepath.VolumeName(value) != ""
+}
+
+func (op scpOperand) isRemote() bool {
+	return op.node != ""
+}
+
+// argument returns the scp argument for the operand. Paths on the node are addressed by the hostname of the node.
+func (op scpOperand) argument(user, nodeHostname string) argument {
+	if !op.isRemote() {
+		return argument{value: op.path}
+	}
+
+	return argument{value: fmt.Sprintf("%s@%s:%s", user, bracketIPv6(nodeHostname), op.path)}
+}
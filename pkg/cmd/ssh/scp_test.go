@@ -0,0 +1,74 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	internalfake "github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/ssh"
+)
+
+var _ = Describe("SCP Options", func() {
+	var (
+		factory *internalfake.Factory
+		o       *ssh.SCPOptions
+	)
+
+	BeforeEach(func() {
+		streams, _, _, _ := util.NewTestIOStreams()
+		factory = internalfake.NewFakeFactory(nil, nil, nil, nil)
+
+		o = ssh.NewSCPOptions(streams)
+		o.CIDRs = []string{"8.8.8.8/32"}
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(o.SSHPublicKeyFile.String())).To(Succeed())
+		Expect(os.Remove(o.SSHPrivateKeyFile.String())).To(Succeed())
+	})
+
+	It("should take the node name from the remote path", func() {
+		Expect(o.Complete(factory, nil, []string{"/tmp/script.sh", "node-1:/tmp"})).To(Succeed())
+		Expect(o.NodeName).To(Equal("node-1"))
+		Expect(o.Interactive).To(BeTrue())
+		Expect(o.Validate()).To(Succeed())
+	})
+
+	It("should require a remote path", func() {
+		Expect(o.Complete(factory, nil, []string{"/tmp/script.sh", "./dir/file:name"})).To(Succeed())
+		Expect(o.Validate()).To(MatchError("at least one path must refer to a node in the format NODE_NAME:PATH"))
+	})
+
+	It("should require all remote paths to refer to the same node", func() {
+		Expect(o.Complete(factory, nil, []string{"node-1:/var/log/a.log", "node-2:/var/log/b.log", "."})).To(Succeed())
+		Expect(o.Validate()).To(MatchError(`all paths must refer to the same node, got "node-1" and "node-2"`))
+	})
+})
+
+var _ = Describe("SCP Command", func() {
+	DescribeTable("completing local paths",
+		func(toComplete string) {
+			streams, _, _, _ := util.NewTestIOStreams()
+			cmd := ssh.NewCmdSCP(internalfake.NewFakeFactory(nil, nil, nil, nil), ssh.NewSCPOptions(streams))
+
+			completions, directive := cmd.ValidArgsFunction(cmd, nil, toComplete)
+			Expect(completions).To(BeEmpty())
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveDefault))
+		},
+		Entry("relative path", "./scr"),
+		Entry("parent directory", "../"),
+		Entry("absolute path", "/tmp/scr"),
+		Entry("home directory", "~/scr"),
+		Entry("path of a subdirectory", "scripts/"),
+	)
+})
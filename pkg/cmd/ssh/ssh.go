@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/klog/v2"
 
 	"github.com/gardener/gardenctl-v2/internal/util"
@@ -25,6 +26,7 @@ func NewCmdSSH(f util.Factory, o *SSHOptions) *cobra.Command {
 		Long: `Establish an SSH connection to a node of a Shoot cluster by specifying its name. 
 
 A bastion is created to access the node and is automatically cleaned up afterwards.
+With --method debug-pod, a privileged pod is scheduled on the node instead, which is deleted when the interactive session ends.
 
 If a node name is not provided, gardenctl will display the hostnames/IPs of the Shoot worker nodes and the corresponding SSH command.
 To connect to a desired node, copy the printed SSH command, replace the target hostname accordingly, and execute the command.`,
@@ -34,10 +36,31 @@ gardenctl ssh my-shoot-node-1
 # Establish an SSH connection with custom CIDRs to allow access to the bastion host
 gardenctl ssh my-shoot-node-1 --cidr 10.1.2.3/32
 
+# Forward local port 8080 to the kubelet port of a Shoot cluster node without opening a remote shell
+gardenctl ssh my-shoot-node-1 --forward 8080:localhost:10250 --forward-only
+
+# Establish an SSH connection with the SSH client built into gardenctl, without requiring an ssh binary
+gardenctl ssh my-shoot-node-1 --client native
+
+# Establish an SSH connection and record the output of the session to the recordings directory of the garden home directory
+gardenctl ssh my-shoot-node-1 --record
+
+# Open a shell on a Shoot cluster node by attaching to a privileged pod instead of creating a bastion, e.g. for infrastructures without bastion support
+gardenctl ssh my-shoot-node-1 --method debug-pod
+
+# Establish an SSH connection to a ready node of a worker pool, choosing interactively if several nodes match
+gardenctl ssh --pool worker-1
+
+# Establish an SSH connection to a random ready node in an availability zone
+gardenctl ssh --zone eu-west-1a --random
+
 # Establish an SSH connection to any Shoot cluster node
 # Copy the printed SSH command, replace the 'IP_OR_HOSTNAME' placeholder for the target hostname/IP, and execute the command to connect to the desired node
 gardenctl ssh
 
+# Write an ssh_config file for the bastion and all nodes, so that "ssh NODE_NAME" works after adding the printed Include line to ~/.ssh/config
+gardenctl ssh --emit-ssh-config
+
 # Create the bastion and output the connection information in JSON format
 gardenctl ssh --no-keepalive --keep-bastion --interactive=false --output json
 
@@ -46,40 +69,36 @@ gardenctl ssh --keep-bastion --bastion-name cli-xxxxxxxx --public-key-file /path
 `,
 		Args: cobra.MaximumNArgs(1),
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-			ctx := f.Context()
-			logger := klog.FromContext(ctx)
-
 			if len(args) != 0 {
 				return nil, cobra.ShellCompDirectiveNoFileComp
 			}
 
-			manager, err := f.Manager()
-			if err != nil {
-				logger.Error(err, "could not get manager from factory")
-				return nil, cobra.ShellCompDirectiveNoFileComp
-			}
-
-			nodeNames, err := getNodeNamesFromMachinesOrNodes(ctx, manager)
-			if err != nil {
-				logger.Error(err, "could not get node names from shoot")
-				return nil, cobra.ShellCompDirectiveNoFileComp
-			}
-
-			var completions []string
-			for _, nodeName := range nodeNames {
-				if strings.HasPrefix(nodeName, toComplete) {
-					completions = append(completions, nodeName)
-				}
-			}
-
-			return completions, cobra.ShellCompDirectiveNoFileComp
+			return nodeNameCompletions(f, toComplete, ""), cobra.ShellCompDirectiveNoFileComp
 		},
 		RunE: base.WrapRunE(o, f),
 	}
 
+	cmd.AddCommand(NewCmdSSHExec(f, NewSSHExecOptions(o.IOStreams)))
+	cmd.AddCommand(NewCmdSSHProxy(f, NewSSHProxyOptions(o.IOStreams)))
+	cmd.AddCommand(NewCmdSSHKnownHosts(f, o.IOStreams))
+
 	o.AddFlags(cmd.Flags())
 	o.RegisterCompletionsForOutputFlag(cmd)
 	o.RegisterCompletionFuncsForStrictHostKeyCheckings(cmd)
+	registerCompletionFuncForKeyType(cmd)
+	utilruntime.Must(cmd.RegisterFlagCompletionFunc("client", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{
+			string(SSHClientOpenSSH) + "\tRun the ssh binary",
+			string(SSHClientNative) + "\tUse the SSH client built into gardenctl",
+		}, cobra.ShellCompDirectiveNoFileComp
+	}))
+
+	utilruntime.Must(cmd.RegisterFlagCompletionFunc("method", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{
+			string(SSHMethodBastion) + "\tConnect with SSH through a bastion",
+			string(SSHMethodDebugPod) + "\tAttach to a privileged pod on the node",
+		}, cobra.ShellCompDirectiveNoFileComp
+	}))
 
 	o.AccessConfig.AddFlags(cmd.Flags())
 	RegisterCompletionFuncsForAccessConfigFlags(cmd, f)
@@ -89,3 +108,37 @@ gardenctl ssh --keep-bastion --bastion-name cli-xxxxxxxx --public-key-file /path
 
 	return cmd
 }
+
+// nodeNameCompletions returns the names of the shoot cluster nodes that start with toComplete, each followed by suffix.
+func nodeNameCompletions(f util.Factory, toComplete string, suffix string) []string {
+	ctx := f.Context()
+	logger := klog.FromContext(ctx)
+
+	manager, err := f.Manager()
+	if err != nil {
+		logger.Error(err, "could not get manager from factory")
+		return nil
+	}
+
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		logger.Error(err, "could not get current target")
+		return nil
+	}
+
+	nodeNames, err := getNodeNamesFromMachinesOrNodes(ctx, manager, currentTarget)
+	if err != nil {
+		logger.Error(err, "could not get node names from shoot")
+		return nil
+	}
+
+	var completions []string
+
+	for _, nodeName := range nodeNames {
+		if strings.HasPrefix(nodeName, toComplete) {
+			completions = append(completions, nodeName+suffix)
+		}
+	}
+
+	return completions
+}
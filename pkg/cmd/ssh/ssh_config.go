@@ -0,0 +1,125 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssh
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// sshConfigDirectoryName is the name of the directory below the session directory that contains the generated ssh_config files.
+const sshConfigDirectoryName = "ssh"
+
+// sshConfigFilePath returns the path of the ssh_config file for the bastion within the given session directory.
+func sshConfigFilePath(sessionDir string, bastionKey client.ObjectKey) string {
+	return filepath.Join(sessionDir, sshConfigDirectoryName, fmt.Sprintf("%s--%s.config", bastionKey.Namespace, bastionKey.Name))
+}
+
+// writeSSHConfig writes an ssh_config file with a Host entry for the bastion and for each node of the connect information
+// that has an address. The node entries use the bastion entry as ProxyJump host.
+func writeSSHConfig(path string, info *ConnectInformation) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory for SSH config file: %w", err)
+	}
+
+	if err := os.WriteFile(path, renderSSHConfig(info), 0o600); err != nil {
+		return fmt.Errorf("failed to write SSH config file: %w", err)
+	}
+
+	return nil
+}
+
+// renderSSHConfig returns the ssh_config content for the connect information.
+func renderSSHConfig(info *ConnectInformation) []byte {
+	buf := bytes.Buffer{}
+
+	fmt.Fprintf(&buf, "# Generated by gardenctl for bastion %s/%s, removed when the bastion is cleaned up.\n\n", info.Bastion.Namespace, info.Bastion.Name)
+
+	fmt.Fprintf(&buf, "Host %s\n", info.Bastion.Name)
+	fmt.Fprintf(&buf, "  HostName %s\n", sshConfigValue(info.Bastion.PreferredAddress))
+
+	if info.Bastion.Port != "" {
+		fmt.Fprintf(&buf, "  Port %s\n", info.Bastion.Port)
+	}
+
+	fmt.Fprintf(&buf, "  User %s\n", SSHBastionUsername)
+
+	if info.Bastion.SSHPrivateKeyFile != "" {
+		fmt.Fprintf(&buf, "  IdentityFile %s\n", sshConfigValue(info.Bastion.SSHPrivateKeyFile.String()))
+		fmt.Fprintf(&buf, "  IdentitiesOnly yes\n")
+	}
+
+	if info.Bastion.SSHCertificateFile != "" {
+		fmt.Fprintf(&buf, "  CertificateFile %s\n", sshConfigValue(info.Bastion.SSHCertificateFile))
+	}
+
+	writeHostKeyOptions(&buf, info.Bastion.UserKnownHostsFiles, info.Bastion.StrictHostKeyChecking)
+
+	for _, node := range info.Nodes {
+		hostname := node.IP
+		if hostname == "" {
+			hostname = node.Hostname
+		}
+
+		if hostname == "" {
+			continue // the node has not yet joined the cluster
+		}
+
+		fmt.Fprintf(&buf, "\nHost %s\n", node.Name)
+		fmt.Fprintf(&buf, "  HostName %s\n", sshConfigValue(hostname))
+		fmt.Fprintf(&buf, "  User %s\n", sshConfigValue(info.User))
+		fmt.Fprintf(&buf, "  ProxyJump %s\n", info.Bastion.Name)
+
+		if info.Bastion.SSHCertificateFile != "" {
+			if info.Bastion.SSHPrivateKeyFile != "" {
+				fmt.Fprintf(&buf, "  IdentityFile %s\n", sshConfigValue(info.Bastion.SSHPrivateKeyFile.String()))
+			}
+
+			fmt.Fprintf(&buf, "  CertificateFile %s\n", sshConfigValue(info.Bastion.SSHCertificateFile))
+		}
+
+		for _, file := range info.NodePrivateKeyFiles {
+			fmt.Fprintf(&buf, "  IdentityFile %s\n", sshConfigValue(file.String()))
+		}
+
+		fmt.Fprintf(&buf, "  IdentitiesOnly yes\n")
+
+		writeHostKeyOptions(&buf, info.NodeUserKnownHostsFiles, info.NodeStrictHostKeyChecking)
+	}
+
+	return buf.Bytes()
+}
+
+// writeHostKeyOptions writes the UserKnownHostsFile and StrictHostKeyChecking options of a Host entry.
+func writeHostKeyOptions(buf *bytes.Buffer, userKnownHostsFiles []string, strictHostKeyChecking StrictHostKeyChecking) {
+	if len(userKnownHostsFiles) > 0 {
+		values := make([]string, 0, len(userKnownHostsFiles))
+		for _, file := range userKnownHostsFiles {
+			values = append(values, sshConfigValue(file))
+		}
+
+		fmt.Fprintf(buf, "  UserKnownHostsFile %s\n", strings.Join(values, " "))
+	}
+
+	if strictHostKeyChecking != "" {
+		fmt.Fprintf(buf, "  StrictHostKeyChecking %s\n", strictHostKeyChecking)
+	}
+}
+
+// sshConfigValue quotes the value for an ssh_config file if it contains whitespace.
+func sshConfigValue(value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return `"` + value + `"`
+	}
+
+	return value
+}
@@ -8,13 +8,19 @@ package ssh_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -27,6 +33,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
 	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -82,6 +89,17 @@ func waitForBastionThenSetBastionReady(ctx context.Context, gardenClient client.
 	})
 }
 
+// exitCodeError is returned by the fake ssh client to simulate a non-zero exit code.
+type exitCodeError int
+
+func (e exitCodeError) Error() string {
+	return fmt.Sprintf("exit status %d", int(e))
+}
+
+func (e exitCodeError) ExitCode() int {
+	return int(e)
+}
+
 var _ = Describe("SSH Command", func() {
 	const (
 		gardenName           = "mygarden"
@@ -182,6 +200,7 @@ var _ = Describe("SSH Command", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "test-shoot",
 				Namespace: *testProject.Spec.Namespace,
+				UID:       "test-shoot-uid",
 			},
 			Spec: gardencorev1beta1.ShootSpec{
 				SeedName: ptr.To(testSeed.Name),
@@ -318,6 +337,7 @@ var _ = Describe("SSH Command", func() {
 		gardenTempDir, err = os.MkdirTemp("", "garden-temp-*")
 		Expect(err).ToNot(HaveOccurred())
 		factory.GardenTempDirectory = gardenTempDir
+		factory.SessionDirectory = gardenTempDir
 	})
 
 	AfterEach(func() {
@@ -330,133 +350,1047 @@ var _ = Describe("SSH Command", func() {
 		Expect(os.RemoveAll(gardenTempDir)).To(Succeed())
 	})
 
+	Describe("known-hosts", func() {
+		var (
+			bastionKnownHostsFile string
+			nodeKnownHostsFile    string
+			hostKey               cryptossh.PublicKey
+		)
+
+		writeKnownHosts := func(path string, lines ...string) {
+			Expect(os.MkdirAll(filepath.Dir(path), 0o700)).To(Succeed())
+			Expect(os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600)).To(Succeed())
+		}
+
+		// writeBastionOwner records the garden of a bastion next to its known_hosts file, like the ssh command
+		writeBastionOwner := func(uid, garden string) {
+			owner := fmt.Sprintf(`{"garden":%q,"namespace":%q,"name":%q}`, garden, *testProject.Spec.Namespace, bastionName)
+			Expect(os.WriteFile(filepath.Join(gardenTempDir, "cache", uid, "bastion.json"), []byte(owner), 0o600)).To(Succeed())
+		}
+
+		runCommand := func(args ...string) error {
+			cmd := ssh.NewCmdSSHKnownHosts(factory, streams)
+			cmd.SetArgs(args)
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+
+			return cmd.ExecuteContext(ctx)
+		}
+
+		BeforeEach(func() {
+			clientProvider.EXPECT().FromClientConfig(gomock.Any()).Return(shootClient, nil).AnyTimes()
+
+			publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			hostKey, err = cryptossh.NewPublicKey(publicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			// the shoot has a node with an internal IP and a bastion that still exists
+			testNode.Status.Addresses = append(testNode.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.250.0.2"})
+			Expect(shootClient.Status().Update(ctx, testNode)).To(Succeed())
+
+			Expect(gardenClient.Create(ctx, &operationsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      bastionName,
+					Namespace: *testProject.Spec.Namespace,
+					UID:       "existing-bastion-uid",
+				},
+			})).To(Succeed())
+
+			bastionKnownHostsFile = filepath.Join(gardenTempDir, "cache", "deleted-bastion-uid", ".ssh", "known_hosts")
+			writeKnownHosts(bastionKnownHostsFile, knownhosts.Line([]string{bastionIP}, hostKey))
+			writeBastionOwner("deleted-bastion-uid", gardenName)
+			writeKnownHosts(filepath.Join(gardenTempDir, "cache", "existing-bastion-uid", ".ssh", "known_hosts"), knownhosts.Line([]string{bastionHostname}, hostKey))
+			writeBastionOwner("existing-bastion-uid", gardenName)
+
+			nodeKnownHostsFile = filepath.Join(gardenHomeDir, "cache", string(testShoot.UID), ".ssh", "known_hosts")
+			writeKnownHosts(nodeKnownHostsFile,
+				"# managed by gardenctl",
+				knownhosts.Line([]string{"10.250.0.2:22"}, hostKey),
+				knownhosts.Line([]string{"10.250.0.3:22"}, hostKey),
+				knownhosts.Line([]string{knownhosts.HashHostname("10.250.0.4")}, hostKey),
+			)
+		})
+
+		It("should list the entries of all known_hosts files", func() {
+			Expect(runCommand("list")).To(Succeed())
+
+			Expect(out.String()).To(MatchRegexp(`bastion\s+deleted-bastion-uid\s+%s\s+ssh-ed25519\s+SHA256:`, bastionIP))
+			Expect(out.String()).To(MatchRegexp(`bastion\s+existing-bastion-uid\s+%s\s+ssh-ed25519`, bastionHostname))
+			Expect(out.String()).To(MatchRegexp(`node\s+test-shoot-uid\s+10.250.0.3\s+ssh-ed25519`))
+		})
+
+		It("should list the entries in JSON format", func() {
+			Expect(runCommand("list", "-o", "json")).To(Succeed())
+
+			var entries []ssh.KnownHostEntry
+			Expect(json.Unmarshal([]byte(out.String()), &entries)).To(Succeed())
+			Expect(entries).To(HaveLen(5))
+			Expect(entries[2]).To(Equal(ssh.KnownHostEntry{
+				Kind:        ssh.KnownHostsKindNode,
+				UID:         "test-shoot-uid",
+				File:        nodeKnownHostsFile,
+				Line:        2,
+				Hosts:       []string{"10.250.0.2"},
+				KeyType:     hostKey.Type(),
+				Fingerprint: cryptossh.FingerprintSHA256(hostKey),
+			}))
+		})
+
+		It("should prune the entries of deleted bastions and nodes", func() {
+			Expect(runCommand("prune")).To(Succeed())
+
+			Expect(bastionKnownHostsFile).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(gardenTempDir, "cache", "deleted-bastion-uid")).NotTo(BeADirectory())
+			Expect(filepath.Join(gardenTempDir, "cache", "existing-bastion-uid", ".ssh", "known_hosts")).To(BeAnExistingFile())
+
+			Expect(os.ReadFile(nodeKnownHostsFile)).To(Equal([]byte("# managed by gardenctl\n" + knownhosts.Line([]string{"10.250.0.2"}, hostKey) + "\n")))
+			Expect(out.String()).To(ContainSubstring("Removed known hosts of deleted bastion deleted-bastion-uid"))
+			Expect(out.String()).To(ContainSubstring(fmt.Sprintf("Removed ssh-ed25519 key of 10.250.0.3 (%s:3)", nodeKnownHostsFile)))
+		})
+
+		It("should keep the known_hosts files of bastions of other gardens", func() {
+			otherGardenKnownHostsFile := filepath.Join(gardenTempDir, "cache", "other-garden-bastion-uid", ".ssh", "known_hosts")
+			writeKnownHosts(otherGardenKnownHostsFile, knownhosts.Line([]string{"192.0.2.1"}, hostKey))
+			writeBastionOwner("other-garden-bastion-uid", "other-garden")
+
+			unknownKnownHostsFile := filepath.Join(gardenTempDir, "cache", "unknown-bastion-uid", ".ssh", "known_hosts")
+			writeKnownHosts(unknownKnownHostsFile, knownhosts.Line([]string{"192.0.2.2"}, hostKey))
+
+			Expect(runCommand("prune")).To(Succeed())
+
+			Expect(bastionKnownHostsFile).NotTo(BeAnExistingFile())
+			Expect(otherGardenKnownHostsFile).To(BeAnExistingFile())
+			Expect(unknownKnownHostsFile).To(BeAnExistingFile())
+			Expect(out.String()).NotTo(ContainSubstring("other-garden-bastion-uid"))
+			Expect(out.String()).NotTo(ContainSubstring("unknown-bastion-uid"))
+		})
+
+		It("should only print the entries that would be pruned in dry-run mode", func() {
+			Expect(runCommand("prune", "--dry-run")).To(Succeed())
+
+			Expect(bastionKnownHostsFile).To(BeAnExistingFile())
+			Expect(os.ReadFile(nodeKnownHostsFile)).To(ContainSubstring("10.250.0.3"))
+			Expect(out.String()).To(ContainSubstring("Would remove known hosts of deleted bastion deleted-bastion-uid"))
+			Expect(out.String()).To(ContainSubstring("Would remove ssh-ed25519 key of 10.250.0.3"))
+		})
+
+		It("should forget a host", func() {
+			Expect(runCommand("forget", "10.250.0.4")).To(Succeed())
+
+			content, err := os.ReadFile(nodeKnownHostsFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(HaveLen(3))
+			Expect(out.String()).To(ContainSubstring(fmt.Sprintf("(%s:4)", nodeKnownHostsFile)))
+
+			Expect(runCommand("forget", "10.250.0.4")).To(Succeed())
+			Expect(out.String()).To(HaveSuffix("No known hosts entries found for 10.250.0.4\n"))
+		})
+	})
+
 	Describe("RunE", func() {
+		// useCertKubeconfig switches to a garden kubeconfig with a client certificate, so that the current user can be determined
+		useCertKubeconfig := func() {
+			caCert, err := internalfake.NewCaCert()
+			Expect(err).NotTo(HaveOccurred())
+			clientCert, err := internalfake.NewClientCert(caCert, "test-user", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			kubeconfigFile := filepath.Join(gardenHomeDir, "kubeconfig.yaml")
+			Expect(clientcmd.WriteToFile(*internalfake.NewCertConfig("garden", clientCert.CertificatePEM), kubeconfigFile)).To(Succeed())
+			cfg.Gardens[0].Kubeconfig = kubeconfigFile
+
+			gardenClientConfig, err := cfg.ClientConfig(gardenName)
+			Expect(err).NotTo(HaveOccurred())
+			seedClientConfig, err := clientcmd.NewClientConfigFromBytes(seedKubeconfigSecret.Data["kubeconfig"])
+			Expect(err).NotTo(HaveOccurred())
+
+			provider := clientmocks.NewMockProvider(ctrl)
+			provider.EXPECT().FromClientConfig(gomock.Eq(gardenClientConfig)).Return(gardenClient, nil).AnyTimes()
+			provider.EXPECT().FromClientConfig(gomock.Eq(seedClientConfig)).Return(seedClient, nil).AnyTimes()
+			provider.EXPECT().FromClientConfig(gomock.Any()).Return(shootClient, nil).AnyTimes()
+			factory.ClientProviderImpl = provider
+		}
+
 		BeforeEach(func() {
 			seedClientConfig, err := clientcmd.NewClientConfigFromBytes(seedKubeconfigSecret.Data["kubeconfig"])
 			Expect(err).NotTo(HaveOccurred())
 
-			clientProvider.EXPECT().FromClientConfig(gomock.Eq(seedClientConfig)).Return(seedClient, nil).AnyTimes()
+			clientProvider.EXPECT().FromClientConfig(gomock.Eq(seedClientConfig)).Return(seedClient, nil).AnyTimes()
+
+			clientProvider.EXPECT().FromClientConfig(gomock.Any()).Return(shootClient, nil).AnyTimes().
+				Do(func(clientConfig clientcmd.ClientConfig) {
+					config, err := clientConfig.RawConfig()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(config.CurrentContext).To(Equal(testShoot.Namespace + "--" + testShoot.Name + "-" + testShoot.Status.AdvertisedAddresses[0].Name))
+				})
+		})
+
+		It("should reject bad options", func() {
+			o := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(util.NewFactoryImpl(), o)
+
+			Expect(cmd.RunE(cmd, nil)).NotTo(Succeed())
+		})
+
+		It("should print the SSH command and then wait for user interrupt", func() {
+			options := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(factory, options)
+
+			go func() {
+				defer GinkgoRecover()
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+
+				// simulate an external controller processing the bastion and proving a successful status
+				waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				Eventually(func() bool {
+					return strings.Contains(logs.String(), bastionIP)
+				}).Should(BeTrue())
+			}()
+
+			// let the magic happen
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			// assert the output
+			Expect(logs.String()).To(ContainSubstring(bastionName))
+			Expect(logs.String()).To(ContainSubstring(bastionHostname))
+			Expect(out.String()).To(ContainSubstring(bastionIP))
+
+			// assert that the bastion has been cleaned up
+			key := types.NamespacedName{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+			bastion := &operationsv1alpha1.Bastion{}
+
+			Expect(gardenClient.Get(ctx, key, bastion)).NotTo(Succeed())
+
+			// assert that no temporary SSH keypair remained on disk
+			_, err := os.Stat(options.SSHPublicKeyFile.String())
+			Expect(err).To(HaveOccurred())
+
+			_, err = os.Stat(options.SSHPrivateKeyFile.String())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should connect to a given node", func() {
+			options := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(factory, options)
+
+			// simulate an external controller processing the bastion and proving a successful status
+			go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+			bastionKey := client.ObjectKey{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+
+			// do not actually execute any commands
+			executedCommands := 0
+			ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+				executedCommands++
+
+				// Retrieve the bastion object to get its UID
+				bastion := &operationsv1alpha1.Bastion{}
+				Expect(gardenClient.Get(ctx, bastionKey, bastion)).To(Succeed())
+
+				bastionUID := string(bastion.UID)
+				shootUID := string(testShoot.UID)
+
+				defaultBastionKnownHostsFile := filepath.Join(gardenTempDir, "cache", bastionUID, ".ssh", "known_hosts")
+				defaultNodeKnownHostsFile := filepath.Join(gardenHomeDir, "cache", shootUID, ".ssh", "known_hosts")
+
+				// the bastion is recorded next to its known_hosts file, so that it can be attributed to the garden
+				Expect(os.ReadFile(filepath.Join(gardenTempDir, "cache", bastionUID, "bastion.json"))).To(MatchJSON(fmt.Sprintf(`{"garden":%q,"namespace":%q,"name":%q}`, gardenName, *testProject.Spec.Namespace, bastionName)))
+
+				Expect(command).To(Equal("ssh"))
+				Expect(args).To(Equal([]string{
+					"-oIdentitiesOnly=yes",
+					"-oStrictHostKeyChecking=ask",
+					fmt.Sprintf("-oUserKnownHostsFile='%s'", defaultNodeKnownHostsFile),
+					fmt.Sprintf("-i%s", nodePrivateKeyFile),
+					fmt.Sprintf(
+						"-oProxyCommand=ssh '-W[%%h]:%%p' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '-i%s' '-oUserKnownHostsFile='\"'\"'%s'\"'\"'' '%s@%s' '-p22'",
+						options.SSHPrivateKeyFile,
+						defaultBastionKnownHostsFile,
+						ssh.SSHBastionUsername,
+						bastionIP,
+					),
+					fmt.Sprintf("%s@%s", options.User, nodeHostname),
+				}))
+
+				return nil
+			})
+
+			// let the magic happen
+			Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
+
+			// assert output
+			Expect(executedCommands).To(Equal(1))
+			Expect(logs.String()).To(ContainSubstring(bastionName))
+			Expect(logs.String()).To(ContainSubstring(bastionHostname))
+			Expect(out.String()).To(ContainSubstring(bastionIP))
+
+			// assert that the bastion has been cleaned up
+			bastion := &operationsv1alpha1.Bastion{}
+			Expect(gardenClient.Get(ctx, bastionKey, bastion)).NotTo(Succeed())
+
+			// assert that no temporary SSH keypair remained on disk
+			_, err := os.Stat(options.SSHPublicKeyFile.String())
+			Expect(err).To(HaveOccurred())
+
+			_, err = os.Stat(options.SSHPrivateKeyFile.String())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should present an SSH certificate signed by the CA of the garden", func() {
+			_, caPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			block, err := cryptossh.MarshalPrivateKey(caPrivateKey, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			caKeyFile := filepath.Join(gardenHomeDir, "ssh-ca")
+			Expect(os.WriteFile(caKeyFile, pem.EncodeToMemory(block), 0o600)).To(Succeed())
+
+			cfg.Gardens[0].SSHCertificate = &config.SSHCertificateConfig{CAKeyFile: caKeyFile}
+
+			options := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(factory, options)
+
+			go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+			ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+
+				Expect(options.SSHCertificateFile).NotTo(BeEmpty())
+				Expect(args).To(ContainElement("-oCertificateFile=" + options.SSHCertificateFile))
+				Expect(args).To(ContainElement(ContainSubstring("'-oCertificateFile=" + options.SSHCertificateFile + "'")))
+
+				content, err := os.ReadFile(options.SSHCertificateFile)
+				Expect(err).NotTo(HaveOccurred())
+
+				parsed, _, _, _, err := cryptossh.ParseAuthorizedKey(content)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsed).To(BeAssignableToTypeOf(&cryptossh.Certificate{}))
+				Expect(parsed.(*cryptossh.Certificate).ValidPrincipals).To(Equal([]string{ssh.SSHBastionUsername}))
+
+				return nil
+			})
+
+			Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
+
+			// the certificate has been removed during the cleanup
+			_, err = os.Stat(options.SSHCertificateFile)
+			Expect(err).To(MatchError(os.ErrNotExist))
+		})
+
+		It("should forward ports to a given node", func() {
+			options := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(factory, options)
+			Expect(cmd.Flags().Set("forward", "8080:localhost:10250")).To(Succeed())
+			Expect(cmd.Flags().Set("remote-forward", "9090:localhost:3000")).To(Succeed())
+			Expect(cmd.Flags().Set("forward-only", "true")).To(Succeed())
+
+			go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+			var executedArgs []string
+
+			ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+
+				executedArgs = args
+
+				return nil
+			})
+
+			Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
+
+			Expect(executedArgs).To(ContainElements("-oExitOnForwardFailure=yes", "-L8080:localhost:10250", "-R9090:localhost:3000", "-N"))
+			Expect(executedArgs[len(executedArgs)-1]).To(Equal(fmt.Sprintf("%s@%s", options.User, nodeHostname)))
+
+			Expect(out.String()).To(ContainSubstring("> Forwarding local port localhost:8080 to localhost:10250 on the node\n"))
+			Expect(out.String()).To(ContainSubstring("> Forwarding port localhost:9090 on the node to localhost:3000\n"))
+			Expect(out.String()).To(ContainSubstring("> Press Ctrl-C to stop forwarding"))
+
+			// the command for additional sessions must not forward the ports again
+			Expect(out.String()).NotTo(ContainSubstring("-L8080"))
+		})
+
+		It("should copy files from a given node", func() {
+			options := ssh.NewSCPOptions(streams)
+			cmd := ssh.NewCmdSCP(factory, options)
+			Expect(cmd.Flags().Set("recursive", "true")).To(Succeed())
+
+			go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+			var (
+				executedCommand string
+				executedArgs    []string
+			)
+
+			ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+				executedCommand = command
+				executedArgs = args
+
+				return nil
+			})
+
+			Expect(cmd.RunE(cmd, []string{testNode.Name + ":/var/log", "./logs"})).To(Succeed())
+
+			Expect(executedCommand).To(Equal("scp"))
+			Expect(executedArgs).To(ContainElement(fmt.Sprintf("-i%s", nodePrivateKeyFile)))
+			Expect(executedArgs).To(ContainElement(ContainSubstring(fmt.Sprintf("-oProxyCommand=ssh '-W[%%h]:%%p' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '-i%s'", options.SSHPrivateKeyFile))))
+			Expect(executedArgs[len(executedArgs)-4:]).To(Equal([]string{
+				"-r",
+				"--",
+				fmt.Sprintf("%s@%s:/var/log", options.User, nodeHostname),
+				"./logs",
+			}))
+
+			// assert that the bastion has been cleaned up
+			bastion := &operationsv1alpha1.Bastion{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: bastionName, Namespace: *testProject.Spec.Namespace}, bastion)).NotTo(Succeed())
+		})
+
+		Describe("exec", func() {
+			var (
+				errOut       *util.SafeBytesBuffer
+				executedArgs map[string][]string
+				execMutex    sync.Mutex
+			)
+
+			BeforeEach(func() {
+				streams, _, out, errOut = util.NewTestIOStreams()
+				executedArgs = map[string][]string{}
+
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+					Expect(command).To(Equal("ssh"))
+
+					// the destination precedes the separator of the remote command
+					separator := slices.Index(args, "--")
+					Expect(separator).To(BeNumerically(">", 0))
+					destination := args[separator-1]
+
+					execMutex.Lock()
+					executedArgs[destination] = args
+					execMutex.Unlock()
+
+					if destination == "gardener@monitoring1" {
+						fmt.Fprint(ioStreams.ErrOut, "ssh: connect to host monitoring1 port 22: Connection refused")
+						return exitCodeError(255)
+					}
+
+					fmt.Fprintln(ioStreams.Out, "line 1")
+					fmt.Fprintln(ioStreams.Out, "line 2")
+
+					return nil
+				})
+			})
+
+			It("should run the command on all nodes and prefix the output", func() {
+				cmd := ssh.NewCmdSSHExec(factory, ssh.NewSSHExecOptions(streams))
+				Expect(cmd.Flags().Parse([]string{"--", "journalctl", "-u", "kubelet"})).To(Succeed())
+
+				Expect(cmd.RunE(cmd, cmd.Flags().Args())).To(MatchError("command failed on 1 of 2 nodes"))
+
+				Expect(executedArgs).To(HaveLen(2))
+				Expect(executedArgs["gardener@"+nodeHostname]).To(ContainElements("-oBatchMode=yes", "-T", "-oStrictHostKeyChecking=accept-new"))
+				Expect(executedArgs["gardener@"+nodeHostname][len(executedArgs["gardener@"+nodeHostname])-3:]).To(Equal([]string{"'journalctl'", "'-u'", "'kubelet'"}))
+
+				Expect(out.String()).To(Equal("[node1] line 1\n[node1] line 2\n"))
+				Expect(errOut.String()).To(Equal("[monitoring1] ssh: connect to host monitoring1 port 22: Connection refused\n" +
+					"[monitoring1] command exited with code 255\n"))
+			})
+
+			It("should collect the output of the selected nodes as json", func() {
+				cmd := ssh.NewCmdSSHExec(factory, ssh.NewSSHExecOptions(streams))
+				Expect(cmd.Flags().Parse([]string{"--node", "node1", "-o", "json", "--", "uname", "-r"})).To(Succeed())
+
+				Expect(cmd.RunE(cmd, cmd.Flags().Args())).To(Succeed())
+
+				var results []ssh.ExecResult
+				Expect(json.Unmarshal([]byte(out.String()), &results)).To(Succeed())
+				Expect(results).To(Equal([]ssh.ExecResult{{
+					Node:     "node1",
+					Hostname: nodeHostname,
+					ExitCode: 0,
+					Stdout:   "line 1\nline 2\n",
+				}}))
+			})
+
+			It("should select the nodes of a worker pool", func() {
+				node := testNode.DeepCopy()
+				node.Labels = map[string]string{"worker.gardener.cloud/pool": "worker-1"}
+				Expect(shootClient.Update(ctx, node)).To(Succeed())
+
+				cmd := ssh.NewCmdSSHExec(factory, ssh.NewSSHExecOptions(streams))
+				Expect(cmd.Flags().Parse([]string{"--pool", "worker-1", "--", "uptime"})).To(Succeed())
+
+				Expect(cmd.RunE(cmd, cmd.Flags().Args())).To(Succeed())
+				Expect(executedArgs).To(HaveKey("gardener@" + nodeHostname))
+				Expect(executedArgs).To(HaveLen(1))
+			})
+
+			It("should fail if a node does not match the selection", func() {
+				cmd := ssh.NewCmdSSHExec(factory, ssh.NewSSHExecOptions(streams))
+				Expect(cmd.Flags().Parse([]string{"--pool", "worker-1", "--node", "node1", "--", "uptime"})).To(Succeed())
+
+				Expect(cmd.RunE(cmd, cmd.Flags().Args())).To(MatchError(`node "node1" not found or not matching the selection`))
+				Expect(executedArgs).To(BeEmpty())
+			})
+		})
+
+		It("should open a SOCKS5 proxy through the bastion", func() {
+			var executedArgs []string
+
+			ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+				Expect(command).To(Equal("ssh"))
+				executedArgs = args
+
+				return nil
+			})
+
+			go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+			cmd := ssh.NewCmdSSHProxy(factory, ssh.NewSSHProxyOptions(streams))
+			Expect(cmd.Flags().Parse([]string{"--port", "9050", "--shell", "fish"})).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			Expect(out.String()).To(ContainSubstring("set -gx HTTPS_PROXY 'socks5h://127.0.0.1:9050';\nset -gx ALL_PROXY 'socks5h://127.0.0.1:9050';\n"))
+			Expect(executedArgs).To(ContainElements("-oExitOnForwardFailure=yes", "-N", "-D127.0.0.1:9050", "-p22"))
+			Expect(executedArgs[len(executedArgs)-1]).To(Equal("gardener@" + bastionIP))
+
+			// assert that the bastion has been cleaned up
+			key := types.NamespacedName{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+			Expect(gardenClient.Get(ctx, key, &operationsv1alpha1.Bastion{})).To(Satisfy(apierrors.IsNotFound))
+		})
+
+		Describe("node selection", func() {
+			var destinations []string
+
+			readyNode := func(name, pool, address string) *corev1.Node {
+				return &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: name,
+						Labels: map[string]string{
+							"worker.gardener.cloud/pool":  pool,
+							"topology.kubernetes.io/zone": "zone-a",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: address}},
+						Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				destinations = nil
+
+				Expect(shootClient.Delete(ctx, testNode)).To(Succeed())
+				Expect(shootClient.Create(ctx, readyNode("node1", "worker-1", "10.0.0.1"))).To(Succeed())
+				Expect(shootClient.Create(ctx, readyNode("node2", "worker-1", "10.0.0.2"))).To(Succeed())
+				Expect(shootClient.Create(ctx, readyNode("node3", "worker-2", "10.0.0.3"))).To(Succeed())
+
+				notReady := readyNode("node4", "worker-2", "10.0.0.4")
+				notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+				Expect(shootClient.Create(ctx, notReady)).To(Succeed())
+
+				ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+					defer func() {
+						signalChan <- os.Interrupt
+					}()
+
+					destinations = append(destinations, args[len(args)-1])
+
+					return nil
+				})
+			})
+
+			AfterEach(func() {
+				ssh.SetPick(util.Pick)
+			})
+
+			It("should connect to the only ready node of a worker pool", func() {
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("pool", "worker-2")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(destinations).To(Equal([]string{"gardener@10.0.0.3"}))
+			})
+
+			It("should let the user choose between several ready nodes", func() {
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				ssh.SetPick(func(_ util.IOStreams, prompt string, items []string) (string, error) {
+					Expect(prompt).To(Equal("node> "))
+					Expect(items).To(Equal([]string{"node1", "node2"}))
+
+					return "node2", nil
+				})
+
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("zone", "zone-a")).To(Succeed())
+				Expect(cmd.Flags().Set("selector", "worker.gardener.cloud/pool=worker-1")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(destinations).To(Equal([]string{"gardener@10.0.0.2"}))
+			})
+
+			It("should select a random ready node", func() {
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("pool", "worker-1")).To(Succeed())
+				Expect(cmd.Flags().Set("random", "true")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(destinations).To(ConsistOf(BeElementOf("gardener@10.0.0.1", "gardener@10.0.0.2")))
+			})
+
+			It("should fail without a terminal if several nodes match", func() {
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("pool", "worker-1")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("2 ready nodes match the selection, use --random or a more specific selection")))
+				Expect(destinations).To(BeEmpty())
+			})
+
+			It("should fail if no ready node matches", func() {
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("selector", "worker.gardener.cloud/pool=worker-3")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError("no ready node matches the selection"))
+			})
+		})
+
+		Describe("bastion reuse", func() {
+			const otherBastionName = "other-bastion"
+
+			var bastionKey types.NamespacedName
+
+			// runKeptBastion creates a bastion that is kept after the command exits, like a concurrently running command of the session
+			runKeptBastion := func(cidrs ...string) *ssh.SSHOptions {
+				options := ssh.NewSSHOptions(streams)
+				options.NoKeepalive = true
+				options.KeepBastion = true
+				options.Interactive = false
+
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				cmd := ssh.NewCmdSSH(factory, options)
+				for _, cidr := range cidrs {
+					Expect(cmd.Flags().Set("cidr", cidr)).To(Succeed())
+				}
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				// the created-by annotation is set by the gardener admission plugin
+				bastion := &operationsv1alpha1.Bastion{}
+				Expect(gardenClient.Get(ctx, bastionKey, bastion)).To(Succeed())
+				patch := client.MergeFrom(bastion.DeepCopy())
+				metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, corev1beta1constants.GardenCreatedBy, "test-user")
+				Expect(gardenClient.Patch(ctx, bastion, patch)).To(Succeed())
+
+				return options
+			}
+
+			// interruptWhenWaiting stops the command once it waits for the user to interrupt
+			interruptWhenWaiting := func() {
+				defer GinkgoRecover()
+
+				Eventually(func() bool {
+					return strings.Contains(out.String(), "Press Ctrl-C")
+				}).Should(BeTrue())
+
+				signalChan <- os.Interrupt
+			}
+
+			BeforeEach(func() {
+				bastionKey = types.NamespacedName{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+
+				useCertKubeconfig()
+			})
+
+			It("should reuse a ready bastion of the session and delete it when the last command exits", func() {
+				firstOptions := runKeptBastion()
+
+				ssh.SetBastionNameProvider(func() (string, error) {
+					return otherBastionName, nil
+				})
+
+				go interruptWhenWaiting()
+
+				options := ssh.NewSSHOptions(streams)
+				options.Interactive = false
+
+				cmd := ssh.NewCmdSSH(factory, options)
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(logs.String()).To(ContainSubstring("Reusing bastion"))
+				Expect(options.BastionName).To(Equal(bastionName))
+				Expect(options.SSHPrivateKeyFile.String()).To(HavePrefix(filepath.Join(gardenTempDir, "bastions")))
+
+				Expect(gardenClient.Get(ctx, types.NamespacedName{Name: otherBastionName, Namespace: bastionKey.Namespace}, &operationsv1alpha1.Bastion{})).
+					To(Satisfy(apierrors.IsNotFound))
+				Expect(gardenClient.Get(ctx, bastionKey, &operationsv1alpha1.Bastion{})).To(Satisfy(apierrors.IsNotFound))
+
+				// the stored keys are removed together with the bastion
+				_, err := os.Stat(options.SSHPrivateKeyFile.String())
+				Expect(err).To(MatchError(os.ErrNotExist))
+
+				Expect(os.Remove(firstOptions.SSHPublicKeyFile.String())).To(Succeed())
+				Expect(os.Remove(firstOptions.SSHPrivateKeyFile.String())).To(Succeed())
+			})
+
+			It("should not delete the bastion while it is used by another command of the session", func() {
+				firstOptions := runKeptBastion()
+
+				// simulate another command that is still connected
+				leaseDir := filepath.Join(gardenTempDir, "bastions", bastionKey.Namespace, bastionKey.Name, "leases")
+				Expect(os.WriteFile(filepath.Join(leaseDir, "other"), nil, 0o600)).To(Succeed())
+
+				go interruptWhenWaiting()
+
+				options := ssh.NewSSHOptions(streams)
+				options.Interactive = false
+
+				cmd := ssh.NewCmdSSH(factory, options)
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(logs.String()).To(ContainSubstring("Not deleting bastion, it is still used by other commands of this session"))
+				Expect(gardenClient.Get(ctx, bastionKey, &operationsv1alpha1.Bastion{})).To(Succeed())
+				Expect(firstOptions.SSHPrivateKeyFile.String()).To(BeAnExistingFile())
+
+				Expect(os.Remove(firstOptions.SSHPublicKeyFile.String())).To(Succeed())
+				Expect(os.Remove(firstOptions.SSHPrivateKeyFile.String())).To(Succeed())
+			})
+
+			It("should only update the detected CIDRs in the ingress of a reused bastion", func() {
+				firstOptions := runKeptBastion("203.0.113.0/24")
+
+				ssh.SetKeepAliveInterval(50 * time.Millisecond)
+
+				var publicIP atomic.Value
+
+				publicIP.Store("192.0.2.42")
+
+				factory.PublicIPsImpl = func(context.Context) ([]string, error) {
+					return []string{publicIP.Load().(string)}, nil
+				}
+
+				ingressCIDRs := func() []string {
+					bastion := &operationsv1alpha1.Bastion{}
+					if err := gardenClient.Get(ctx, bastionKey, bastion); err != nil {
+						return nil
+					}
+
+					var cidrs []string
+					for _, policy := range bastion.Spec.Ingress {
+						cidrs = append(cidrs, policy.IPBlock.CIDR)
+					}
+
+					return cidrs
+				}
+
+				go func() {
+					defer GinkgoRecover()
+
+					Eventually(func() bool {
+						return strings.Contains(out.String(), "Press Ctrl-C")
+					}).Should(BeTrue())
+
+					Expect(ingressCIDRs()).To(ConsistOf("203.0.113.0/24", "192.0.2.42/32"))
+
+					// simulate a VPN reconnect
+					publicIP.Store("198.51.100.7")
+
+					Eventually(ingressCIDRs).Should(ConsistOf("203.0.113.0/24", "198.51.100.7/32"))
+					Consistently(ingressCIDRs, 200*time.Millisecond).Should(ConsistOf("203.0.113.0/24", "198.51.100.7/32"))
+
+					signalChan <- os.Interrupt
+				}()
+
+				options := ssh.NewSSHOptions(streams)
+				options.Interactive = false
+
+				cmd := ssh.NewCmdSSH(factory, options)
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(logs.String()).To(ContainSubstring("Reusing bastion"))
+				Expect(logs.String()).To(ContainSubstring("Public IP addresses changed, updating bastion ingress."))
+
+				Expect(os.Remove(firstOptions.SSHPublicKeyFile.String())).To(Succeed())
+				Expect(os.Remove(firstOptions.SSHPrivateKeyFile.String())).To(Succeed())
+			})
+
+			It("should create a new bastion if reuse is disabled", func() {
+				firstOptions := runKeptBastion()
+
+				ssh.SetBastionNameProvider(func() (string, error) {
+					return otherBastionName, nil
+				})
+
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, otherBastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				go interruptWhenWaiting()
+
+				options := ssh.NewSSHOptions(streams)
+				options.Interactive = false
+
+				cmd := ssh.NewCmdSSH(factory, options)
+				Expect(cmd.Flags().Set("reuse-bastion", "false")).To(Succeed())
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(logs.String()).NotTo(ContainSubstring("Reusing bastion"))
+				Expect(options.BastionName).To(Equal(otherBastionName))
+				Expect(gardenClient.Get(ctx, bastionKey, &operationsv1alpha1.Bastion{})).To(Succeed())
+
+				Expect(os.Remove(firstOptions.SSHPublicKeyFile.String())).To(Succeed())
+				Expect(os.Remove(firstOptions.SSHPrivateKeyFile.String())).To(Succeed())
+			})
+		})
+
+		It("should emit an SSH config and remove it during cleanup", func() {
+			options := ssh.NewSSHOptions(streams)
+			options.EmitSSHConfig = true
+
+			cmd := ssh.NewCmdSSH(factory, options)
+
+			configFile := filepath.Join(gardenTempDir, "ssh", *testProject.Spec.Namespace+"--"+bastionName+".config")
+
+			go func() {
+				defer GinkgoRecover()
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+
+				waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				Eventually(func() string {
+					return out.String()
+				}).Should(ContainSubstring("Include " + configFile))
+
+				content, err := os.ReadFile(configFile)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring(fmt.Sprintf("Host %s\n  HostName %s\n  Port 22\n  User gardener\n  IdentityFile %s\n", bastionName, bastionIP, options.SSHPrivateKeyFile)))
+				Expect(string(content)).To(ContainSubstring(fmt.Sprintf("Host node1\n  HostName %s\n  User gardener\n  ProxyJump %s\n  IdentityFile %s\n", nodeHostname, bastionName, nodePrivateKeyFile)))
+				Expect(string(content)).To(ContainSubstring("  UserKnownHostsFile " + filepath.Join(gardenHomeDir, "cache")))
+				Expect(string(content)).NotTo(ContainSubstring("Host " + pendingMachine.Labels[machinev1alpha1.NodeLabelKey]))
+			}()
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			Expect(configFile).NotTo(BeAnExistingFile())
+		})
+
+		Describe("session recording", func() {
+			// recordedSession returns the header and the output events of the only session recording
+			recordedSession := func() (string, map[string]interface{}, [][]interface{}) {
+				files, err := filepath.Glob(filepath.Join(gardenHomeDir, "recordings", "*.cast"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+
+				content, err := os.ReadFile(files[0])
+				Expect(err).NotTo(HaveOccurred())
+
+				lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+
+				header := map[string]interface{}{}
+				Expect(json.Unmarshal([]byte(lines[0]), &header)).To(Succeed())
+
+				var events [][]interface{}
+
+				for _, line := range lines[1:] {
+					var event []interface{}
+					Expect(json.Unmarshal([]byte(line), &event)).To(Succeed())
+					events = append(events, event)
+				}
+
+				return files[0], header, events
+			}
+
+			BeforeEach(func() {
+				useCertKubeconfig()
+
+				ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
+					fmt.Fprint(ioStreams.Out, "hello from node\r\n")
+					return nil
+				})
+			})
+
+			It("should record the output of the interactive session", func() {
+				options := ssh.NewSSHOptions(streams)
+				cmd := ssh.NewCmdSSH(factory, options)
+				Expect(cmd.Flags().Set("record", "true")).To(Succeed())
+
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
+
+				file, header, events := recordedSession()
+				Expect(file).To(HaveSuffix(fmt.Sprintf("--%s--%s--%s.cast", testProject.Name, testShoot.Name, testNode.Name)))
+				Expect(out.String()).To(ContainSubstring("> Recording this session to " + file))
+
+				Expect(header).To(HaveKeyWithValue("version", BeEquivalentTo(2)))
+				Expect(header).To(HaveKeyWithValue("gardenctl", And(
+					HaveKeyWithValue("target", Equal(map[string]interface{}{
+						"garden":  gardenName,
+						"project": testProject.Name,
+						"shoot":   testShoot.Name,
+					})),
+					HaveKeyWithValue("node", testNode.Name),
+					HaveKeyWithValue("bastion", *testProject.Spec.Namespace+"/"+bastionName),
+					HaveKeyWithValue("user", "test-user"),
+				)))
+
+				Expect(events).NotTo(BeEmpty())
+				Expect(events[len(events)-1][1]).To(Equal("o"))
+				Expect(events[len(events)-1][2]).To(Equal("hello from node\r\n"))
+			})
+
+			It("should record the session if recording is mandatory for the garden", func() {
+				cfg.Gardens[0].RecordSSHSessions = true
+
+				options := ssh.NewSSHOptions(streams)
+				cmd := ssh.NewCmdSSH(factory, options)
+
+				go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
+
+				Expect(options.Record).To(BeTrue())
+				recordedSession()
+			})
+
+			It("should refuse to only create the bastion if recording is mandatory for the garden", func() {
+				cfg.Gardens[0].RecordSSHSessions = true
+
+				options := ssh.NewSSHOptions(streams)
+				cmd := ssh.NewCmdSSH(factory, options)
 
-			clientProvider.EXPECT().FromClientConfig(gomock.Any()).Return(shootClient, nil).AnyTimes().
-				Do(func(clientConfig clientcmd.ClientConfig) {
-					config, err := clientConfig.RawConfig()
-					Expect(err).NotTo(HaveOccurred())
-					Expect(config.CurrentContext).To(Equal(testShoot.Namespace + "--" + testShoot.Name + "-" + testShoot.Status.AdvertisedAddresses[0].Name))
-				})
-		})
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring(fmt.Sprintf("SSH sessions must be recorded for garden %q", gardenName))))
 
-		It("should reject bad options", func() {
-			o := ssh.NewSSHOptions(streams)
-			cmd := ssh.NewCmdSSH(util.NewFactoryImpl(), o)
+				// the bastion has not been created
+				bastion := &operationsv1alpha1.Bastion{}
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Name: bastionName, Namespace: *testProject.Spec.Namespace}, bastion)).NotTo(Succeed())
+			})
 
-			Expect(cmd.RunE(cmd, nil)).NotTo(Succeed())
-		})
+			It("should refuse to run a command on the nodes if recording is mandatory for the garden", func() {
+				cfg.Gardens[0].RecordSSHSessions = true
 
-		It("should print the SSH command and then wait for user interrupt", func() {
-			options := ssh.NewSSHOptions(streams)
-			cmd := ssh.NewCmdSSH(factory, options)
+				cmd := ssh.NewCmdSSHExec(factory, ssh.NewSSHExecOptions(streams))
+				Expect(cmd.Flags().Parse([]string{"--", "uptime"})).To(Succeed())
 
-			go func() {
-				defer GinkgoRecover()
-				defer func() {
-					signalChan <- os.Interrupt
-				}()
+				Expect(cmd.RunE(cmd, cmd.Flags().Args())).To(MatchError(ContainSubstring(fmt.Sprintf("SSH sessions must be recorded for garden %q", gardenName))))
 
-				// simulate an external controller processing the bastion and proving a successful status
-				waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+				// the bastion has not been created
+				bastion := &operationsv1alpha1.Bastion{}
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Name: bastionName, Namespace: *testProject.Spec.Namespace}, bastion)).NotTo(Succeed())
+			})
 
-				Eventually(func() bool {
-					return strings.Contains(logs.String(), bastionIP)
-				}).Should(BeTrue())
-			}()
+			It("should refuse to copy files if recording is mandatory for the garden", func() {
+				cfg.Gardens[0].RecordSSHSessions = true
 
-			// let the magic happen
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				cmd := ssh.NewCmdSCP(factory, ssh.NewSCPOptions(streams))
 
-			// assert the output
-			Expect(logs.String()).To(ContainSubstring(bastionName))
-			Expect(logs.String()).To(ContainSubstring(bastionHostname))
-			Expect(out.String()).To(ContainSubstring(bastionIP))
+				Expect(cmd.RunE(cmd, []string{testNode.Name + ":/var/log", "./logs"})).To(MatchError(ContainSubstring(fmt.Sprintf("SSH sessions must be recorded for garden %q", gardenName))))
 
-			// assert that the bastion has been cleaned up
-			key := types.NamespacedName{Name: bastionName, Namespace: *testProject.Spec.Namespace}
-			bastion := &operationsv1alpha1.Bastion{}
+				bastion := &operationsv1alpha1.Bastion{}
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Name: bastionName, Namespace: *testProject.Spec.Namespace}, bastion)).NotTo(Succeed())
+			})
+		})
 
-			Expect(gardenClient.Get(ctx, key, bastion)).NotTo(Succeed())
+		Describe("debug pod", func() {
+			var attachedPods []*corev1.Pod
 
-			// assert that no temporary SSH keypair remained on disk
-			_, err := os.Stat(options.SSHPublicKeyFile.String())
-			Expect(err).To(HaveOccurred())
+			// setDebugPodRunning simulates the kubelet starting the container of the debug pod
+			setDebugPodRunning := func() {
+				defer GinkgoRecover()
 
-			_, err = os.Stat(options.SSHPrivateKeyFile.String())
-			Expect(err).To(HaveOccurred())
-		})
+				pods := &corev1.PodList{}
 
-		It("should connect to a given node", func() {
-			options := ssh.NewSSHOptions(streams)
-			cmd := ssh.NewCmdSSH(factory, options)
+				Eventually(func() ([]corev1.Pod, error) {
+					err := shootClient.List(ctx, pods, client.InNamespace(metav1.NamespaceDefault))
+					return pods.Items, err
+				}).Should(HaveLen(1))
 
-			// simulate an external controller processing the bastion and proving a successful status
-			go waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+				pod := pods.Items[0]
+				pod.Status.Phase = corev1.PodRunning
+				Expect(shootClient.Status().Update(ctx, &pod)).To(Succeed())
+			}
 
-			bastionKey := client.ObjectKey{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+			BeforeEach(func() {
+				attachedPods = nil
 
-			// do not actually execute any commands
-			executedCommands := 0
-			ssh.SetExecCommand(func(ctx context.Context, command string, args []string, ioStreams util.IOStreams) error {
-				defer func() {
-					signalChan <- os.Interrupt
-				}()
-				executedCommands++
+				ssh.SetPollDebugPodStatusInterval(100 * time.Millisecond)
+				ssh.SetAttachToPod(func(ctx context.Context, clientConfig clientcmd.ClientConfig, pod *corev1.Pod, ioStreams util.IOStreams) error {
+					attachedPods = append(attachedPods, pod.DeepCopy())
+					return nil
+				})
+			})
 
-				// Retrieve the bastion object to get its UID
-				bastion := &operationsv1alpha1.Bastion{}
-				Expect(gardenClient.Get(ctx, bastionKey, bastion)).To(Succeed())
+			It("should attach to a privileged pod on the node and delete it afterwards", func() {
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("method", "debug-pod")).To(Succeed())
 
-				bastionUID := string(bastion.UID)
-				shootUID := string(testShoot.UID)
+				go setDebugPodRunning()
 
-				defaultBastionKnownHostsFile := filepath.Join(gardenTempDir, "cache", bastionUID, ".ssh", "known_hosts")
-				defaultNodeKnownHostsFile := filepath.Join(gardenHomeDir, "cache", shootUID, ".ssh", "known_hosts")
+				Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
 
-				Expect(command).To(Equal("ssh"))
-				Expect(args).To(Equal([]string{
-					"-oIdentitiesOnly=yes",
-					"-oStrictHostKeyChecking=ask",
-					fmt.Sprintf("-oUserKnownHostsFile='%s'", defaultNodeKnownHostsFile),
-					fmt.Sprintf("-i%s", nodePrivateKeyFile),
-					fmt.Sprintf(
-						"-oProxyCommand=ssh '-W[%%h]:%%p' -oStrictHostKeyChecking=ask -oIdentitiesOnly=yes '-i%s' '-oUserKnownHostsFile='\"'\"'%s'\"'\"'' '%s@%s' '-p22'",
-						options.SSHPrivateKeyFile,
-						defaultBastionKnownHostsFile,
-						ssh.SSHBastionUsername,
-						bastionIP,
-					),
-					fmt.Sprintf("%s@%s", options.User, nodeHostname),
-				}))
+				Expect(attachedPods).To(HaveLen(1))
+				pod := attachedPods[0]
+				Expect(pod.Spec.NodeName).To(Equal(testNode.Name))
+				Expect(pod.Spec.HostPID).To(BeTrue())
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+				Expect(pod.Spec.Containers[0].Image).To(Equal("busybox"))
+				Expect(pod.Spec.Containers[0].Command).To(HaveExactElements("chroot", "/host", "/bin/sh", "-c", ContainSubstring("bash -l")))
+				Expect(pod.Spec.Containers[0].SecurityContext.Privileged).To(Equal(ptr.To(true)))
+				Expect(out.String()).To(ContainSubstring("> Attaching to debug pod default/" + pod.Name))
 
-				return nil
+				// the pod has been deleted
+				Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Satisfy(apierrors.IsNotFound))
+
+				// no bastion has been created
+				bastions := &operationsv1alpha1.BastionList{}
+				Expect(gardenClient.List(ctx, bastions)).To(Succeed())
+				Expect(bastions.Items).To(BeEmpty())
 			})
 
-			// let the magic happen
-			Expect(cmd.RunE(cmd, []string{testNode.Name})).To(Succeed())
+			It("should delete the pod if attaching fails", func() {
+				ssh.SetAttachToPod(func(ctx context.Context, clientConfig clientcmd.ClientConfig, pod *corev1.Pod, ioStreams util.IOStreams) error {
+					return errors.New("attach failed")
+				})
 
-			// assert output
-			Expect(executedCommands).To(Equal(1))
-			Expect(logs.String()).To(ContainSubstring(bastionName))
-			Expect(logs.String()).To(ContainSubstring(bastionHostname))
-			Expect(out.String()).To(ContainSubstring(bastionIP))
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("method", "debug-pod")).To(Succeed())
+				Expect(cmd.Flags().Set("debug-pod-image", "example.com/toolbox:1.0")).To(Succeed())
 
-			// assert that the bastion has been cleaned up
-			bastion := &operationsv1alpha1.Bastion{}
-			Expect(gardenClient.Get(ctx, bastionKey, bastion)).NotTo(Succeed())
+				go setDebugPodRunning()
 
-			// assert that no temporary SSH keypair remained on disk
-			_, err := os.Stat(options.SSHPublicKeyFile.String())
-			Expect(err).To(HaveOccurred())
+				Expect(cmd.RunE(cmd, []string{testNode.Name})).To(MatchError("attach failed"))
 
-			_, err = os.Stat(options.SSHPrivateKeyFile.String())
-			Expect(err).To(HaveOccurred())
+				pods := &corev1.PodList{}
+				Expect(shootClient.List(ctx, pods)).To(Succeed())
+				Expect(pods.Items).To(BeEmpty())
+			})
+
+			It("should fail if the node does not exist", func() {
+				cmd := ssh.NewCmdSSH(factory, ssh.NewSSHOptions(streams))
+				Expect(cmd.Flags().Set("method", "debug-pod")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, []string{"unknown-node"})).To(MatchError(`node "unknown-node" not found in the Shoot cluster`))
+				Expect(attachedPods).To(BeEmpty())
+			})
 		})
 
 		It("should connect to a given node that has not yet joined the cluster", func() {
@@ -590,6 +1524,107 @@ var _ = Describe("SSH Command", func() {
 			Expect(bastion.Annotations).To(HaveKeyWithValue(corev1beta1constants.GardenerOperation, corev1beta1constants.GardenerOperationKeepalive))
 		})
 
+		It("should update the bastion ingress when the public IP addresses change", func() {
+			options := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(factory, options)
+
+			ssh.SetKeepAliveInterval(50 * time.Millisecond)
+
+			var publicIP atomic.Value
+
+			publicIP.Store("192.0.2.42")
+
+			factory.PublicIPsImpl = func(context.Context) ([]string, error) {
+				return []string{publicIP.Load().(string)}, nil
+			}
+
+			key := types.NamespacedName{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+
+			ingressCIDRs := func() []string {
+				bastion := &operationsv1alpha1.Bastion{}
+				if err := gardenClient.Get(ctx, key, bastion); err != nil {
+					return nil
+				}
+
+				var cidrs []string
+				for _, policy := range bastion.Spec.Ingress {
+					cidrs = append(cidrs, policy.IPBlock.CIDR)
+				}
+
+				return cidrs
+			}
+
+			go func() {
+				defer GinkgoRecover()
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+
+				waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				Eventually(func() bool {
+					return strings.Contains(logs.String(), bastionIP)
+				}).Should(BeTrue())
+
+				Expect(ingressCIDRs()).To(Equal([]string{"192.0.2.42/32"}))
+
+				// simulate a VPN reconnect
+				publicIP.Store("198.51.100.7")
+
+				Eventually(ingressCIDRs).Should(Equal([]string{"198.51.100.7/32"}))
+			}()
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			Expect(logs.String()).To(ContainSubstring("Public IP addresses changed, updating bastion ingress."))
+		})
+
+		It("should not update the bastion ingress of user-provided CIDRs", func() {
+			options := ssh.NewSSHOptions(streams)
+			cmd := ssh.NewCmdSSH(factory, options)
+			Expect(cmd.Flags().Set("cidr", "203.0.113.0/24")).To(Succeed())
+
+			ssh.SetKeepAliveInterval(50 * time.Millisecond)
+
+			detected := make(chan struct{}, 1)
+
+			factory.PublicIPsImpl = func(context.Context) ([]string, error) {
+				select {
+				case detected <- struct{}{}:
+				default:
+				}
+
+				return []string{"198.51.100.7"}, nil
+			}
+
+			key := types.NamespacedName{Name: bastionName, Namespace: *testProject.Spec.Namespace}
+
+			go func() {
+				defer GinkgoRecover()
+				defer func() {
+					signalChan <- os.Interrupt
+				}()
+
+				waitForBastionThenSetBastionReady(ctx, gardenClient, bastionName, *testProject.Spec.Namespace, bastionHostname, bastionIP)
+
+				Eventually(func() bool {
+					return strings.Contains(logs.String(), bastionIP)
+				}).Should(BeTrue())
+
+				// wait for a few keepalive intervals
+				time.Sleep(200 * time.Millisecond)
+
+				bastion := &operationsv1alpha1.Bastion{}
+				Expect(gardenClient.Get(ctx, key, bastion)).To(Succeed())
+				Expect(bastion.Spec.Ingress).To(HaveLen(1))
+				Expect(bastion.Spec.Ingress[0].IPBlock.CIDR).To(Equal("203.0.113.0/24"))
+			}()
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			Expect(detected).To(BeEmpty())
+		})
+
 		It("should stop keepalive when bastion is deleted ", func() {
 			options := ssh.NewSSHOptions(streams)
 			options.KeepBastion = true // we need to assert its annotations later
@@ -882,6 +1917,58 @@ var _ = Describe("SSH Options", func() {
 			Expect(o.GeneratedSSHKeys).To(BeTrue())
 		})
 
+		DescribeTable("should generate a keypair of the given key type",
+			func(keyType ssh.SSHKeyType, algorithm string) {
+				o.KeyType = keyType
+
+				Expect(o.Complete(factory, nil, nil)).To(Succeed())
+
+				privateKey, err := os.ReadFile(o.SSHPrivateKeyFile.String())
+				Expect(err).NotTo(HaveOccurred())
+
+				signer, err := cryptossh.ParsePrivateKey(privateKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(signer.PublicKey().Type()).To(Equal(algorithm))
+
+				publicKey, err := os.ReadFile(o.SSHPublicKeyFile.String())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(publicKey)).To(HavePrefix(algorithm + " "))
+				Expect(filepath.Base(o.SSHPrivateKeyFile.String())).To(HavePrefix("gen_id_" + string(keyType) + "_"))
+			},
+			Entry("rsa", ssh.SSHKeyTypeRSA, cryptossh.KeyAlgoRSA),
+			Entry("ed25519", ssh.SSHKeyTypeED25519, cryptossh.KeyAlgoED25519),
+			Entry("ecdsa", ssh.SSHKeyTypeECDSA, cryptossh.KeyAlgoECDSA256),
+		)
+
+		It("should use the key type of the targeted garden", func() {
+			cfg := &config.Config{
+				LinkKubeconfig: ptr.To(false),
+				Gardens:        []config.Garden{{Name: "test", SSHKeyType: "ed25519"}},
+			}
+			factory = internalfake.NewFakeFactory(cfg, nil, nil, internalfake.NewFakeTargetProvider(target.NewTarget("test", "", "", "")))
+
+			Expect(o.Complete(factory, nil, nil)).To(Succeed())
+
+			publicKey, err := os.ReadFile(o.SSHPublicKeyFile.String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(publicKey)).To(HavePrefix(cryptossh.KeyAlgoED25519 + " "))
+		})
+
+		It("should prefer the key type flag over the key type of the targeted garden", func() {
+			cfg := &config.Config{
+				LinkKubeconfig: ptr.To(false),
+				Gardens:        []config.Garden{{Name: "test", SSHKeyType: "ed25519"}},
+			}
+			factory = internalfake.NewFakeFactory(cfg, nil, nil, internalfake.NewFakeTargetProvider(target.NewTarget("test", "", "", "")))
+			o.KeyType = ssh.SSHKeyTypeECDSA
+
+			Expect(o.Complete(factory, nil, nil)).To(Succeed())
+
+			publicKey, err := os.ReadFile(o.SSHPublicKeyFile.String())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(publicKey)).To(HavePrefix(cryptossh.KeyAlgoECDSA256 + " "))
+		})
+
 		It("should complete bastion name", func() {
 			Expect(o.Complete(factory, nil, nil)).To(Succeed())
 
@@ -896,6 +1983,12 @@ var _ = Describe("SSH Options", func() {
 			Expect(o.BastionName).To(Equal("cli-xxxxxx"))
 		})
 
+		It("should stay in interactive mode if the node is selected by worker pool", func() {
+			o.Pool = "worker-1"
+			Expect(o.Complete(factory, nil, nil)).To(Succeed())
+			Expect(o.Interactive).To(BeTrue())
+		})
+
 		It("should switch to non-interactive mode if no node name given", func() {
 			o.Interactive = true
 
@@ -979,6 +2072,123 @@ var _ = Describe("SSH Options", func() {
 				Expect(o.Validate()).NotTo(Succeed())
 			})
 		})
+		Context("port forwarding", func() {
+			BeforeEach(func() {
+				o.NodeName = "node1"
+				o.PortForwards = []ssh.PortForward{{Port: 8080, Host: "localhost", HostPort: 10250}}
+			})
+
+			It("should validate", func() {
+				Expect(o.Validate()).To(Succeed())
+			})
+
+			It("should validate with a node selection", func() {
+				o.NodeName = ""
+				o.Random = true
+
+				Expect(o.Validate()).To(Succeed())
+			})
+
+			It("should require interactive mode", func() {
+				o.Interactive = false
+
+				Expect(o.Validate()).To(MatchError("port forwarding requires a NODE_NAME and --interactive=true"))
+			})
+
+			It("should require a node", func() {
+				o.NodeName = ""
+
+				Expect(o.Validate()).To(MatchError("port forwarding requires a NODE_NAME and --interactive=true"))
+			})
+
+			It("should require a port forwarding when only forwarding", func() {
+				o.PortForwards = nil
+				o.ForwardOnly = true
+
+				Expect(o.Validate()).To(MatchError("set --forward or --remote-forward when using --forward-only"))
+			})
+
+			It("should not be supported by the native SSH client", func() {
+				o.Client = ssh.SSHClientNative
+
+				Expect(o.Validate()).To(MatchError("port forwarding is not supported by the native SSH client"))
+			})
+		})
+
+		It("should require an interactive session to record", func() {
+			o.Record = true
+			o.ForwardOnly = true
+			o.PortForwards = []ssh.PortForward{{Port: 8080, Host: "localhost", HostPort: 10250}}
+
+			Expect(o.Validate()).To(MatchError("session recording requires an interactive SSH session to a node without --forward-only"))
+		})
+
+		Context("debug pod", func() {
+			BeforeEach(func() {
+				o = ssh.NewSSHOptions(streams)
+				o.Method = ssh.SSHMethodDebugPod
+				o.NodeName = "node1"
+			})
+
+			It("should validate without a bastion configuration", func() {
+				Expect(o.Validate()).To(Succeed())
+			})
+
+			It("should require a node", func() {
+				o.NodeName = ""
+				o.Interactive = false
+
+				Expect(o.Validate()).To(MatchError(ContainSubstring("the debug-pod method requires NODE_NAME")))
+			})
+
+			It("should not support port forwarding", func() {
+				o.PortForwards = []ssh.PortForward{{Port: 8080, Host: "localhost", HostPort: 10250}}
+
+				Expect(o.Validate()).To(MatchError("port forwarding is not supported by the debug-pod method"))
+			})
+
+			It("should not support session recording", func() {
+				o.Record = true
+
+				Expect(o.Validate()).To(MatchError("session recording is not supported by the debug-pod method"))
+			})
+
+			It("should require an image", func() {
+				o.DebugPodImage = ""
+
+				Expect(o.Validate()).To(MatchError("the debug pod image must not be empty"))
+			})
+		})
+
+		It("should require non-interactive mode to emit an SSH config", func() {
+			o.Interactive = true
+			o.EmitSSHConfig = true
+
+			Expect(o.Validate()).To(MatchError("set --interactive=false when emitting an SSH config"))
+		})
+
+		It("should reject an invalid proxy port", func() {
+			proxyOptions := ssh.NewSSHProxyOptions(streams)
+			proxyOptions.CIDRs = o.CIDRs
+			proxyOptions.SSHPublicKeyFile = o.SSHPublicKeyFile
+			proxyOptions.Port = 0
+
+			Expect(proxyOptions.Validate()).To(MatchError(`invalid proxy port: invalid port "0"`))
+		})
+
+		It("should not allow a node name together with a node selection", func() {
+			o.NodeName = "node1"
+			o.Random = true
+
+			Expect(o.Validate()).To(MatchError("NODE_NAME cannot be combined with --pool, --zone, --selector or --random"))
+		})
+
+		It("should reject an invalid label selector", func() {
+			o.Selector = "a in (b"
+
+			Expect(o.Validate()).To(MatchError(ContainSubstring("invalid label selector")))
+		})
+
 		It("should require a public SSH key file", func() {
 			o := ssh.NewSSHOptions(streams)
 			o.CIDRs = []string{"8.8.8.8/32"}
@@ -0,0 +1,232 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package status
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
+// ShootHealth is the health summary of a shoot.
+type ShootHealth struct {
+	// Garden is the name of the garden the shoot belongs to
+	Garden string `json:"garden"`
+	// Project is the name of the project the shoot belongs to
+	Project string `json:"project,omitempty"`
+	// Namespace is the namespace of the shoot
+	Namespace string `json:"namespace"`
+	// Name is the name of the shoot
+	Name string `json:"name"`
+	// Seed is the name of the seed the shoot is scheduled to
+	Seed string `json:"seed,omitempty"`
+	// Healthy is false if one of the conditions is not healthy or the last operation failed
+	Healthy bool `json:"healthy"`
+	// Hibernated is true if the shoot is hibernated
+	Hibernated bool `json:"hibernated"`
+	// HibernationEnabled is true if the hibernation of the shoot is requested
+	HibernationEnabled bool `json:"hibernationEnabled"`
+	// MaintenanceWindow is the time window in which the shoot is maintained
+	MaintenanceWindow *gardencorev1beta1.MaintenanceTimeWindow `json:"maintenanceWindow,omitempty"`
+	// LastOperation is the last operation on the shoot
+	LastOperation *gardencorev1beta1.LastOperation `json:"lastOperation,omitempty"`
+	// LastErrors are the errors of the last operation
+	LastErrors []gardencorev1beta1.LastError `json:"lastErrors,omitempty"`
+	// Conditions are the health conditions of the shoot
+	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
+	// Constraints are the constraints of the shoot
+	Constraints []gardencorev1beta1.Condition `json:"constraints,omitempty"`
+
+	// now is the point in time the ages are computed from
+	now time.Time
+}
+
+var _ fmt.Stringer = &ShootHealth{}
+
+// NewShootHealth returns the health summary of the given shoot.
+func NewShootHealth(gardenName, projectName string, shoot *gardencorev1beta1.Shoot, now time.Time) *ShootHealth {
+	health := &ShootHealth{
+		Garden:        gardenName,
+		Project:       projectName,
+		Namespace:     shoot.Namespace,
+		Name:          shoot.Name,
+		Seed:          ptr.Deref(shoot.Spec.SeedName, ""),
+		Hibernated:    shoot.Status.IsHibernated,
+		LastOperation: shoot.Status.LastOperation,
+		LastErrors:    shoot.Status.LastErrors,
+		Conditions:    shoot.Status.Conditions,
+		Constraints:   shoot.Status.Constraints,
+		now:           now,
+	}
+
+	if shoot.Spec.Hibernation != nil {
+		health.HibernationEnabled = ptr.Deref(shoot.Spec.Hibernation.Enabled, false)
+	}
+
+	if shoot.Spec.Maintenance != nil {
+		health.MaintenanceWindow = shoot.Spec.Maintenance.TimeWindow
+	}
+
+	health.Healthy = IsHealthy(shoot)
+
+	return health
+}
+
+// IsHealthy returns false if one of the shoot conditions is false or unknown, or if the last operation failed.
+// Progressing conditions are considered healthy, as they did not exceed their threshold yet.
+func IsHealthy(shoot *gardencorev1beta1.Shoot) bool {
+	for _, condition := range shoot.Status.Conditions {
+		if condition.Status == gardencorev1beta1.ConditionFalse || condition.Status == gardencorev1beta1.ConditionUnknown {
+			return false
+		}
+	}
+
+	if op := shoot.Status.LastOperation; op != nil {
+		if op.State == gardencorev1beta1.LastOperationStateFailed || op.State == gardencorev1beta1.LastOperationStateError {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the health summary in a human-readable format.
+func (h *ShootHealth) String() string {
+	buf := bytes.Buffer{}
+
+	health := "healthy"
+	if !h.Healthy {
+		health = "unhealthy"
+	}
+
+	fmt.Fprintf(&buf, "Garden:          %s\n", h.Garden)
+
+	if h.Project != "" {
+		fmt.Fprintf(&buf, "Project:         %s\n", h.Project)
+	}
+
+	fmt.Fprintf(&buf, "Shoot:           %s\n", h.Name)
+	fmt.Fprintf(&buf, "Seed:            %s\n", util.ValueOrNone(h.Seed))
+	fmt.Fprintf(&buf, "Health:          %s\n", health)
+	fmt.Fprintf(&buf, "Hibernation:     %s\n", h.hibernation())
+	fmt.Fprintf(&buf, "Maintenance:     %s\n", h.maintenanceWindow())
+	fmt.Fprintf(&buf, "Last Operation:  %s\n", h.lastOperation())
+
+	if h.LastOperation != nil && h.LastOperation.Description != "" {
+		fmt.Fprintf(&buf, "                 %s\n", h.LastOperation.Description)
+	}
+
+	if len(h.LastErrors) > 0 {
+		fmt.Fprintf(&buf, "\nLast Errors:\n")
+
+		for _, lastError := range h.LastErrors {
+			description := strings.TrimSpace(lastError.Description)
+			if lastError.TaskID != nil {
+				description = fmt.Sprintf("%s: %s", *lastError.TaskID, description)
+			}
+
+			fmt.Fprintf(&buf, "- %s\n", description)
+		}
+	}
+
+	if len(h.Conditions) > 0 {
+		fmt.Fprintf(&buf, "\nConditions:\n")
+		h.printConditions(&buf, h.Conditions)
+	}
+
+	if len(h.Constraints) > 0 {
+		fmt.Fprintf(&buf, "\nConstraints:\n")
+		h.printConditions(&buf, h.Constraints)
+	}
+
+	return buf.String()
+}
+
+func (h *ShootHealth) hibernation() string {
+	switch {
+	case h.Hibernated && h.HibernationEnabled:
+		return "hibernated"
+	case h.Hibernated:
+		return "waking up"
+	case h.HibernationEnabled:
+		return "hibernating"
+	default:
+		return "awake"
+	}
+}
+
+func (h *ShootHealth) maintenanceWindow() string {
+	if h.MaintenanceWindow == nil {
+		return util.None
+	}
+
+	return fmt.Sprintf("%s - %s", h.MaintenanceWindow.Begin, h.MaintenanceWindow.End)
+}
+
+func (h *ShootHealth) lastOperation() string {
+	op := h.LastOperation
+	if op == nil {
+		return util.None
+	}
+
+	return fmt.Sprintf("%s %s (%d%%), %s ago", op.Type, op.State, op.Progress, h.age(op.LastUpdateTime))
+}
+
+func (h *ShootHealth) printConditions(buf *bytes.Buffer, conditions []gardencorev1beta1.Condition) {
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Type", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Reason", Type: "string"},
+			{Name: "Age", Type: "string"},
+			{Name: "Message", Type: "string"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+
+	for _, condition := range conditions {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				string(condition.Type),
+				string(condition.Status),
+				util.ValueOrNone(condition.Reason),
+				h.age(condition.LastTransitionTime),
+				firstLine(condition.Message),
+			},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+	if err := printer.PrintObj(table, buf); err != nil {
+		klog.Background().Error(err, "failed to output condition table")
+	}
+}
+
+func (h *ShootHealth) age(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(h.now.Sub(t.Time))
+}
+
+// firstLine returns the first line of a possibly multi-line message.
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	return line
+}
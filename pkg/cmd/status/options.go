@@ -0,0 +1,143 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// StatusOptions is a struct to support status command.
+type StatusOptions struct {
+	base.Options
+
+	// CurrentTarget holds the current target configuration
+	CurrentTarget target.Target
+
+	// GardenClient is the client for the garden cluster
+	GardenClient clientgarden.Client
+
+	// Watch defines if the command waits until the last operation of the shoot finished
+	Watch bool
+
+	// Timeout is the maximum time to wait for the last operation to finish
+	Timeout time.Duration
+}
+
+// NewStatusOptions returns initialized StatusOptions.
+func NewStatusOptions(ioStreams util.IOStreams) *StatusOptions {
+	return &StatusOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+// AddFlags binds the command options to a given flagset.
+func (o *StatusOptions) AddFlags(flags *pflag.FlagSet) {
+	o.Options.AddFlags(flags)
+
+	flags.BoolVarP(&o.Watch, "watch", "w", o.Watch, "Watch the shoot and wait until its last operation finished. The progress of the operation is streamed while waiting")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the last operation to finish when watching the shoot")
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *StatusOptions) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	currentTarget, err := manager.CurrentTarget()
+	if err != nil {
+		return err
+	}
+
+	if currentTarget.GardenName() == "" {
+		return target.ErrNoGardenTargeted
+	}
+
+	if currentTarget.ShootName() == "" {
+		return target.ErrNoShootTargeted
+	}
+
+	o.CurrentTarget = currentTarget
+
+	gardenClient, err := manager.GardenClient(currentTarget.GardenName())
+	if err != nil {
+		return fmt.Errorf("failed to create garden cluster client: %w", err)
+	}
+
+	o.GardenClient = gardenClient
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *StatusOptions) Validate() error {
+	if o.Watch && o.Timeout <= 0 {
+		return errors.New("--timeout must be greater than zero")
+	}
+
+	return o.Options.Validate()
+}
+
+// Run executes the command.
+func (o *StatusOptions) Run(f util.Factory) error {
+	ctx := f.Context()
+
+	shoot, err := o.GardenClient.FindShoot(ctx, o.CurrentTarget.AsListOption())
+	if err != nil {
+		return err
+	}
+
+	if o.Watch {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+
+		// keep the standard output parsable if a structured output format is requested
+		var progressOut io.Writer = o.IOStreams.Out
+		if o.Output != "" {
+			progressOut = o.IOStreams.ErrOut
+		}
+
+		shoot, err = WaitForOperation(ctx, o.GardenClient.RuntimeClient(), shoot, progressOut)
+		if err != nil {
+			return err
+		}
+
+		if o.Output == "" {
+			fmt.Fprintln(o.IOStreams.Out)
+		}
+	}
+
+	health := NewShootHealth(o.CurrentTarget.GardenName(), o.CurrentTarget.ProjectName(), shoot, f.Clock().Now())
+
+	if err := o.PrintObject(health); err != nil {
+		return err
+	}
+
+	if !health.Healthy {
+		return fmt.Errorf("shoot %q is unhealthy", shoot.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package status
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/flags"
+)
+
+// NewCmdStatus returns a new status command.
+func NewCmdStatus(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := NewStatusOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show a health summary of the targeted shoot",
+		Long: `Show a health summary of the targeted shoot.
+The summary contains the conditions and constraints of the shoot, its last operation and errors, the maintenance window, the hibernation state and the seed the shoot is scheduled to.
+The command exits with a non-zero exit code if one of the shoot conditions is not healthy or the last operation failed.
+
+With --watch the command waits until the last operation of the shoot finished, e.g. after a reconciliation has been triggered or while the shoot wakes up from hibernation.
+The progress of the operation is streamed while waiting. Afterwards the summary is printed and the exit code is determined as described above.
+If the operation does not finish within the given timeout, the command exits with a non-zero exit code.`,
+		Example: `# show the health summary of the targeted shoot
+gardenctl status
+
+# show the health summary of shoot my-shoot in json format
+gardenctl status --project my-project --shoot my-shoot -o json
+
+# wait up to 10 minutes for the last operation of the targeted shoot to finish
+gardenctl status --watch --timeout 10m`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	f.TargetFlags().AddGardenFlag(cmd.Flags())
+	f.TargetFlags().AddProjectFlag(cmd.Flags())
+	f.TargetFlags().AddShootFlag(cmd.Flags())
+	flags.RegisterCompletionFuncsForTargetFlags(cmd, f, ioStreams, cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,326 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package status_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clientgarden "github.com/gardener/gardenctl-v2/internal/client/garden"
+	"github.com/gardener/gardenctl-v2/internal/fake"
+	"github.com/gardener/gardenctl-v2/internal/util"
+	utilmocks "github.com/gardener/gardenctl-v2/internal/util/mocks"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/status"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+	targetmocks "github.com/gardener/gardenctl-v2/pkg/target/mocks"
+)
+
+var _ = Describe("Status Command", func() {
+	const gardenName = "mygarden"
+
+	var (
+		ctrl          *gomock.Controller
+		factory       *utilmocks.MockFactory
+		manager       *targetmocks.MockManager
+		clock         *utilmocks.MockClock
+		streams       util.IOStreams
+		out           *util.SafeBytesBuffer
+		currentTarget target.Target
+		now           time.Time
+		shoot         *gardencorev1beta1.Shoot
+		runtimeClient client.Client
+		cmd           *cobra.Command
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = utilmocks.NewMockFactory(ctrl)
+		manager = targetmocks.NewMockManager(ctrl)
+		clock = utilmocks.NewMockClock(ctrl)
+
+		now = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+		transitioned := metav1.NewTime(now.Add(-2 * time.Hour))
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myshoot",
+				Namespace: "garden-prod",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To("myseed"),
+				Maintenance: &gardencorev1beta1.Maintenance{
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:           gardencorev1beta1.LastOperationTypeReconcile,
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					Progress:       100,
+					Description:    "Shoot cluster has been successfully reconciled.",
+					LastUpdateTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+				},
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: "APIServerAvailable", Status: gardencorev1beta1.ConditionTrue, Reason: "HealthzRequestSucceeded", Message: "API server /healthz endpoint responded with success status code.", LastTransitionTime: transitioned},
+					{Type: "EveryNodeReady", Status: gardencorev1beta1.ConditionProgressing, Reason: "NodesRollingUpdate", Message: "Worker pool is rolling.", LastTransitionTime: transitioned},
+				},
+				Constraints: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.ShootHibernationPossible, Status: gardencorev1beta1.ConditionTrue, Reason: "NoProblematicWebhooks", LastTransitionTime: transitioned},
+				},
+			},
+		}
+
+		currentTarget = target.NewTarget(gardenName, "prod", "", "myshoot")
+
+		factory.EXPECT().Context().Return(context.Background()).AnyTimes()
+		factory.EXPECT().Manager().Return(manager, nil).AnyTimes()
+		factory.EXPECT().Clock().Return(clock).AnyTimes()
+		factory.EXPECT().TargetFlags().Return(target.NewTargetFlags("", "", "", "", false)).AnyTimes()
+		clock.EXPECT().Now().Return(now).AnyTimes()
+		manager.EXPECT().CurrentTarget().DoAndReturn(func() (target.Target, error) {
+			return currentTarget, nil
+		}).AnyTimes()
+
+		streams, _, out, _ = util.NewTestIOStreams()
+		cmd = status.NewCmdStatus(factory, streams)
+	})
+
+	JustBeforeEach(func() {
+		runtimeClient = fake.NewClientWithObjects(
+			&gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-prod")},
+			},
+			shoot,
+		)
+		gardenClient := clientgarden.NewClient(nil, runtimeClient, gardenName)
+		manager.EXPECT().GardenClient(gardenName).Return(gardenClient, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should print the health summary of a healthy shoot", func() {
+		Expect(cmd.RunE(cmd, nil)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Shoot:           myshoot\n"))
+		Expect(out.String()).To(ContainSubstring("Seed:            myseed\n"))
+		Expect(out.String()).To(ContainSubstring("Health:          healthy\n"))
+		Expect(out.String()).To(ContainSubstring("Hibernation:     awake\n"))
+		Expect(out.String()).To(ContainSubstring("Maintenance:     220000+0100 - 230000+0100\n"))
+		Expect(out.String()).To(ContainSubstring("Last Operation:  Reconcile Succeeded (100%), 5m ago\n"))
+		Expect(out.String()).To(MatchRegexp(`Conditions:\nTYPE\s+STATUS\s+REASON\s+AGE\s+MESSAGE\n` +
+			`APIServerAvailable\s+True\s+HealthzRequestSucceeded\s+120m\s+API server /healthz endpoint responded with success status code.\n` +
+			`EveryNodeReady\s+Progressing\s+NodesRollingUpdate\s+120m\s+Worker pool is rolling.\n`))
+		Expect(out.String()).To(MatchRegexp(`Constraints:\nTYPE\s+STATUS\s+REASON\s+AGE\s+MESSAGE\n` +
+			`HibernationPossible\s+True\s+NoProblematicWebhooks\s+120m\s+\n`))
+	})
+
+	Context("when a condition is unhealthy", func() {
+		BeforeEach(func() {
+			shoot.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+			shoot.Status.LastErrors = []gardencorev1beta1.LastError{
+				{Description: "task failed", TaskID: ptr.To("Waiting until the Kubernetes API server is ready")},
+			}
+		})
+
+		It("should print the summary and fail", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(`shoot "myshoot" is unhealthy`))
+			Expect(out.String()).To(ContainSubstring("Health:          unhealthy\n"))
+			Expect(out.String()).To(ContainSubstring("Last Errors:\n- Waiting until the Kubernetes API server is ready: task failed\n"))
+		})
+
+		It("should print the summary as json and fail", func() {
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(`shoot "myshoot" is unhealthy`))
+			Expect(out.String()).To(ContainSubstring(`"healthy": false`))
+			Expect(out.String()).To(ContainSubstring(`"seed": "myseed"`))
+		})
+	})
+
+	Context("when the last operation failed", func() {
+		BeforeEach(func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+		})
+
+		It("should fail", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(`shoot "myshoot" is unhealthy`))
+		})
+	})
+
+	Context("when the shoot is hibernated", func() {
+		BeforeEach(func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			shoot.Status.IsHibernated = true
+		})
+
+		It("should print the hibernation state", func() {
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("Hibernation:     hibernated\n"))
+		})
+	})
+
+	It("should fail if no shoot is targeted", func() {
+		currentTarget = target.NewTarget(gardenName, "prod", "", "")
+
+		Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring(target.ErrNoShootTargeted.Error())))
+	})
+
+	Context("when watching the shoot", func() {
+		updateLastOperation := func(state gardencorev1beta1.LastOperationState, progress int32, description string) {
+			current := &gardencorev1beta1.Shoot{}
+			ExpectWithOffset(1, runtimeClient.Get(context.Background(), client.ObjectKeyFromObject(shoot), current)).To(Succeed())
+
+			current.Status.LastOperation.State = state
+			current.Status.LastOperation.Progress = progress
+			current.Status.LastOperation.Description = description
+			ExpectWithOffset(1, runtimeClient.Update(context.Background(), current)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			shoot.Status.LastOperation.Progress = 10
+			shoot.Status.LastOperation.Description = "Deploying the control plane"
+
+			Expect(cmd.Flags().Set("watch", "true")).To(Succeed())
+		})
+
+		It("should stream the progress until the operation succeeded", func() {
+			errCh := make(chan error, 1)
+
+			go func() {
+				defer GinkgoRecover()
+				errCh <- cmd.RunE(cmd, nil)
+			}()
+
+			Eventually(out.String).Should(ContainSubstring("Reconcile Processing (10%): Deploying the control plane\n"))
+			Eventually(func() string {
+				updateLastOperation(gardencorev1beta1.LastOperationStateProcessing, 50, "Waiting for the workers")
+				return out.String()
+			}).Should(ContainSubstring("Reconcile Processing (50%): Waiting for the workers\n"))
+
+			updateLastOperation(gardencorev1beta1.LastOperationStateSucceeded, 100, "Shoot cluster has been successfully reconciled.")
+
+			Eventually(errCh).Should(Receive(BeNil()))
+			Expect(out.String()).To(HavePrefix("Reconcile Processing (10%): Deploying the control plane\n" +
+				"Reconcile Processing (50%): Waiting for the workers\n" +
+				"Reconcile Succeeded (100%): Shoot cluster has been successfully reconciled.\n\n" +
+				"Garden:          mygarden\n"))
+			Expect(out.String()).To(ContainSubstring("Health:          healthy\n"))
+		})
+
+		It("should fail if the operation failed", func() {
+			errCh := make(chan error, 1)
+
+			go func() {
+				defer GinkgoRecover()
+				errCh <- cmd.RunE(cmd, nil)
+			}()
+
+			Eventually(func() string {
+				updateLastOperation(gardencorev1beta1.LastOperationStateFailed, 80, "Flow failed")
+				return out.String()
+			}).Should(ContainSubstring("Reconcile Failed (80%): Flow failed\n"))
+
+			Eventually(errCh).Should(Receive(MatchError(`shoot "myshoot" is unhealthy`)))
+			Expect(out.String()).To(ContainSubstring("Health:          unhealthy\n"))
+		})
+
+		It("should fail if the operation does not finish in time", func() {
+			Expect(cmd.Flags().Set("timeout", "100ms")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(`timed out waiting for the last operation of shoot "myshoot" to finish`))
+		})
+
+		Context("when the operation already finished", func() {
+			BeforeEach(func() {
+				shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+				shoot.Status.LastOperation.Progress = 100
+				shoot.Status.LastOperation.Description = "Shoot cluster has been successfully reconciled."
+			})
+
+			It("should not wait", func() {
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(out.String()).To(HavePrefix("Reconcile Succeeded (100%): Shoot cluster has been successfully reconciled.\n\n"))
+			})
+		})
+
+		It("should reject a non-positive timeout", func() {
+			Expect(cmd.Flags().Set("timeout", "0s")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError("--timeout must be greater than zero"))
+		})
+	})
+})
+
+// expiringWatchClient fails the first watch with an expired resource version, like the API server does if the
+// resource version of a re-established watch is too old.
+type expiringWatchClient struct {
+	client.WithWatch
+
+	expired bool
+}
+
+func (c *expiringWatchClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	if c.expired {
+		return c.WithWatch.Watch(ctx, list, opts...)
+	}
+
+	c.expired = true
+
+	w := watch.NewFake()
+	go w.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+
+	return w, nil
+}
+
+var _ = Describe("WaitForOperation", func() {
+	It("should re-establish the watch if the resource version expired", func() {
+		ctx := context.Background()
+
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "myshoot", Namespace: "garden-prod"},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:     gardencorev1beta1.LastOperationTypeReconcile,
+					State:    gardencorev1beta1.LastOperationStateProcessing,
+					Progress: 10,
+				},
+			},
+		}
+
+		c := &expiringWatchClient{WithWatch: fake.NewClientWithObjects(shoot).(client.WithWatch)}
+
+		stale := &gardencorev1beta1.Shoot{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), stale)).To(Succeed())
+
+		// the operation finishes while the watch is not established
+		current := stale.DeepCopy()
+		current.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+		current.Status.LastOperation.Progress = 100
+		Expect(c.Update(ctx, current)).To(Succeed())
+
+		out := &util.SafeBytesBuffer{}
+
+		finished, err := status.WaitForOperation(ctx, c, stale, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(finished.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		Expect(out.String()).To(Equal("Reconcile Processing (10%)\nReconcile Succeeded (100%)\n"))
+	})
+})
@@ -0,0 +1,161 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WaitForOperation watches the given shoot until its last operation finished, i.e. succeeded, failed or was
+// aborted, and the current generation has been observed by the gardener. The progress of the last operation is
+// streamed to out. The finished shoot is returned.
+func WaitForOperation(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, out io.Writer) (*gardencorev1beta1.Shoot, error) {
+	wc, ok := c.(client.WithWatch)
+	if !ok {
+		return nil, errors.New("garden client does not support watching resources")
+	}
+
+	var progress string
+
+	report := func(shoot *gardencorev1beta1.Shoot) {
+		if p := operationProgress(shoot); p != progress {
+			progress = p
+			fmt.Fprintln(out, progress)
+		}
+	}
+
+	report(shoot)
+
+	for !isOperationFinished(shoot) {
+		w, err := wc.Watch(ctx, &gardencorev1beta1.ShootList{},
+			client.InNamespace(shoot.Namespace),
+			client.MatchingFields{"metadata.name": shoot.Name},
+			&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: shoot.ResourceVersion}},
+		)
+		if err != nil && !isWatchExpired(err) {
+			return nil, fmt.Errorf("failed to watch shoot %q: %w", shoot.Name, err)
+		}
+
+		if err == nil {
+			watched, err := watchShoot(ctx, w, shoot, report)
+			if err == nil {
+				shoot = watched
+				continue
+			}
+
+			if !isWatchExpired(err) {
+				return nil, err
+			}
+		}
+
+		// the resource version is too old to resume the watch, e.g. after a server-side watch timeout,
+		// therefore the watch is re-established from the latest state of the shoot
+		latest := &gardencorev1beta1.Shoot{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(shoot), latest); err != nil {
+			return nil, fmt.Errorf("failed to get shoot %q: %w", shoot.Name, err)
+		}
+
+		shoot = latest
+		report(shoot)
+	}
+
+	return shoot, nil
+}
+
+// watchShoot consumes the events of w until the last operation of the shoot finished or the watch is closed by
+// the server. In the latter case the latest known state of the shoot is returned, so that the watch can be
+// re-established. If the resource version of the watch expired, the returned error satisfies isWatchExpired.
+func watchShoot(ctx context.Context, w watch.Interface, shoot *gardencorev1beta1.Shoot, report func(*gardencorev1beta1.Shoot)) (*gardencorev1beta1.Shoot, error) {
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("timed out waiting for the last operation of shoot %q to finish", shoot.Name)
+			}
+
+			return nil, ctx.Err()
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return shoot, nil
+			}
+
+			switch event.Type {
+			case watch.Error:
+				if err := apierrors.FromObject(event.Object); isWatchExpired(err) {
+					return nil, err
+				}
+
+				return nil, fmt.Errorf("failed to watch shoot %q: %v", shoot.Name, event.Object)
+			case watch.Deleted:
+				if s, ok := event.Object.(*gardencorev1beta1.Shoot); ok && s.Name == shoot.Name {
+					return nil, fmt.Errorf("shoot %q has been deleted", shoot.Name)
+				}
+			case watch.Added, watch.Modified:
+				s, ok := event.Object.(*gardencorev1beta1.Shoot)
+				if !ok || s.Name != shoot.Name {
+					continue
+				}
+
+				shoot = s
+				report(shoot)
+
+				if isOperationFinished(shoot) {
+					return shoot, nil
+				}
+			}
+		}
+	}
+}
+
+// isWatchExpired returns true if the watch cannot be resumed, because its resource version is too old.
+func isWatchExpired(err error) bool {
+	return apierrors.IsResourceExpired(err) || apierrors.IsGone(err)
+}
+
+// isOperationFinished returns true if the gardener observed the current generation of the shoot
+// and the last operation is in a final state.
+func isOperationFinished(shoot *gardencorev1beta1.Shoot) bool {
+	op := shoot.Status.LastOperation
+	if op == nil || shoot.Status.ObservedGeneration != shoot.Generation {
+		return false
+	}
+
+	switch op.State {
+	case gardencorev1beta1.LastOperationStateSucceeded,
+		gardencorev1beta1.LastOperationStateFailed,
+		gardencorev1beta1.LastOperationStateAborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// operationProgress returns a single line describing the progress of the last operation of the shoot.
+func operationProgress(shoot *gardencorev1beta1.Shoot) string {
+	op := shoot.Status.LastOperation
+	if op == nil {
+		return fmt.Sprintf("Waiting for the first operation of shoot %q", shoot.Name)
+	}
+
+	progress := fmt.Sprintf("%s %s (%d%%)", op.Type, op.State, op.Progress)
+	if description := firstLine(op.Description); description != "" {
+		progress = fmt.Sprintf("%s: %s", progress, description)
+	}
+
+	return progress
+}
@@ -0,0 +1,335 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package target
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/config"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// bookmarkPrefix is the prefix of the target command argument that refers to a bookmark.
+const bookmarkPrefix = "@"
+
+// NewCmdBookmark returns a new target bookmark command.
+func NewCmdBookmark(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bookmark",
+		Short: "Manage named targets stored in the gardenctl configuration",
+		Long: `Manage named targets stored in the gardenctl configuration.
+A bookmarked target can be targeted again with "gardenctl target @<name>".`,
+		Example: `# bookmark the current target as "prod"
+gardenctl target bookmark add prod
+
+# target the bookmark "prod"
+gardenctl target @prod`,
+	}
+
+	cmd.AddCommand(NewCmdBookmarkAdd(f, ioStreams))
+	cmd.AddCommand(NewCmdBookmarkList(f, ioStreams))
+	cmd.AddCommand(NewCmdBookmarkDelete(f, ioStreams))
+
+	return cmd
+}
+
+// NewCmdBookmarkAdd returns a new target bookmark add command.
+func NewCmdBookmarkAdd(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &BookmarkAddOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "add NAME",
+		Short: "Bookmark the current target",
+		Long:  "Bookmark the current target, including the control plane flag, under the given name",
+		Example: `# bookmark the current target as "prod"
+gardenctl target bookmark add prod
+
+# replace the existing bookmark "prod" with the current target
+gardenctl target bookmark add prod --overwrite`,
+		Args: cobra.ExactArgs(1),
+		RunE: base.WrapRunE(o, f),
+	}
+
+	cmd.Flags().BoolVar(&o.Overwrite, "overwrite", false, "Replace an existing bookmark with the same name")
+
+	return cmd
+}
+
+// BookmarkAddOptions is a struct to support bookmark add command.
+type BookmarkAddOptions struct {
+	base.Options
+	// Configuration is the gardenctl configuration
+	Configuration *config.Config
+	// Target is the target that is bookmarked
+	Target target.Target
+	// Name is the name of the bookmark
+	Name string
+	// Overwrite replaces an existing bookmark with the same name
+	Overwrite bool
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *BookmarkAddOptions) Complete(f util.Factory, _ *cobra.Command, args []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	o.Configuration = manager.Configuration()
+	if o.Configuration == nil {
+		return errors.New("failed to get configuration")
+	}
+
+	o.Target, err = manager.CurrentTarget()
+	if err != nil {
+		return fmt.Errorf("failed to get current target: %w", err)
+	}
+
+	if len(args) > 0 {
+		o.Name = strings.TrimPrefix(strings.TrimSpace(args[0]), bookmarkPrefix)
+	}
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *BookmarkAddOptions) Validate() error {
+	if o.Name == "" {
+		return errors.New("bookmark name is required")
+	}
+
+	if o.Target.GardenName() == "" {
+		return target.ErrNoGardenTargeted
+	}
+
+	return nil
+}
+
+// Run executes the command.
+func (o *BookmarkAddOptions) Run(_ util.Factory) error {
+	bookmark := config.Bookmark{
+		Name:         o.Name,
+		Garden:       o.Target.GardenName(),
+		Project:      o.Target.ProjectName(),
+		Seed:         o.Target.SeedName(),
+		Shoot:        o.Target.ShootName(),
+		ControlPlane: o.Target.ControlPlane(),
+	}
+
+	if i, ok := o.Configuration.IndexOfBookmark(o.Name); ok {
+		if !o.Overwrite {
+			return fmt.Errorf("bookmark %q already exists, use --overwrite to replace it", o.Name)
+		}
+
+		o.Configuration.Bookmarks[i] = bookmark
+	} else {
+		o.Configuration.Bookmarks = append(o.Configuration.Bookmarks, bookmark)
+	}
+
+	if err := o.Configuration.Save(); err != nil {
+		return fmt.Errorf("failed to save bookmark to configuration: %w", err)
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "Successfully bookmarked %s as %q\n", describeTarget(o.Target), o.Name)
+
+	return nil
+}
+
+// NewCmdBookmarkList returns a new target bookmark list command.
+func NewCmdBookmarkList(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &BookmarkListOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all bookmarks",
+		Example: `# list all bookmarks
+gardenctl target bookmark list
+
+# list all bookmarks in YAML format
+gardenctl target bookmark list -o yaml`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	return cmd
+}
+
+// BookmarkListOptions is a struct to support bookmark list command.
+type BookmarkListOptions struct {
+	base.Options
+	// Bookmarks is the list of configured bookmarks
+	Bookmarks []config.Bookmark
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *BookmarkListOptions) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	cfg := manager.Configuration()
+	if cfg == nil {
+		return errors.New("failed to get configuration")
+	}
+
+	o.Bookmarks = cfg.Bookmarks
+	if o.Bookmarks == nil {
+		o.Bookmarks = []config.Bookmark{}
+	}
+
+	return nil
+}
+
+// Run executes the command.
+func (o *BookmarkListOptions) Run(_ util.Factory) error {
+	if o.Output != "" {
+		return o.PrintObject(o.Bookmarks)
+	}
+
+	if len(o.Bookmarks) == 0 {
+		fmt.Fprintln(o.IOStreams.Out, "No bookmarks defined")
+		return nil
+	}
+
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Garden", Type: "string"},
+			{Name: "Project", Type: "string"},
+			{Name: "Seed", Type: "string"},
+			{Name: "Shoot", Type: "string"},
+			{Name: "Control Plane", Type: "boolean"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+
+	for _, b := range o.Bookmarks {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{b.Name, b.Garden, b.Project, b.Seed, b.Shoot, b.ControlPlane},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+
+	return printer.PrintObj(table, o.IOStreams.Out)
+}
+
+// NewCmdBookmarkDelete returns a new target bookmark delete command.
+func NewCmdBookmarkDelete(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &BookmarkDeleteOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a bookmark",
+		Example: `# delete the bookmark "prod"
+gardenctl target bookmark delete prod`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: validTargetFunctionWrapper(f, ioStreams, TargetKindBookmark),
+		RunE:              base.WrapRunE(o, f),
+	}
+
+	return cmd
+}
+
+// BookmarkDeleteOptions is a struct to support bookmark delete command.
+type BookmarkDeleteOptions struct {
+	base.Options
+	// Configuration is the gardenctl configuration
+	Configuration *config.Config
+	// Name is the name of the bookmark
+	Name string
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *BookmarkDeleteOptions) Complete(f util.Factory, _ *cobra.Command, args []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	o.Configuration = manager.Configuration()
+	if o.Configuration == nil {
+		return errors.New("failed to get configuration")
+	}
+
+	if len(args) > 0 {
+		o.Name = strings.TrimPrefix(strings.TrimSpace(args[0]), bookmarkPrefix)
+	}
+
+	return nil
+}
+
+// Validate validates the provided options.
+func (o *BookmarkDeleteOptions) Validate() error {
+	if o.Name == "" {
+		return errors.New("bookmark name is required")
+	}
+
+	return nil
+}
+
+// Run executes the command.
+func (o *BookmarkDeleteOptions) Run(_ util.Factory) error {
+	i, ok := o.Configuration.IndexOfBookmark(o.Name)
+	if !ok {
+		return fmt.Errorf("bookmark %q is not defined in gardenctl configuration", o.Name)
+	}
+
+	o.Configuration.Bookmarks = append(o.Configuration.Bookmarks[:i], o.Configuration.Bookmarks[i+1:]...)
+
+	if err := o.Configuration.Save(); err != nil {
+		return fmt.Errorf("failed to delete bookmark from configuration: %w", err)
+	}
+
+	fmt.Fprintf(o.IOStreams.Out, "Successfully deleted bookmark %q\n", o.Name)
+
+	return nil
+}
+
+// validBookmarkArgsFunction completes arguments of the target command that refer to a bookmark.
+func validBookmarkArgsFunction(f util.Factory, ioStreams util.IOStreams) cobraValidArgsFunction {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 || !strings.HasPrefix(toComplete, bookmarkPrefix) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		names, err := validTargetArgsFunction(f, TargetKindBookmark)
+		if err != nil {
+			fmt.Fprintln(ioStreams.ErrOut, err.Error())
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		suggestions := make([]string, 0, len(names))
+		for _, name := range names {
+			suggestions = append(suggestions, bookmarkPrefix+name)
+		}
+
+		return util.FilterStringsByPrefix(toComplete, suggestions), cobra.ShellCompDirectiveNoFileComp
+	}
+}
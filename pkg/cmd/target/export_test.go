@@ -6,4 +6,18 @@ SPDX-License-Identifier: Apache-2.0
 
 package target
 
+import (
+	"io"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+)
+
 var ValidTargetArgsFunction = validTargetArgsFunction
+
+func SetIsTerminal(f func(io.Reader) bool) {
+	isTerminal = f
+}
+
+func SetPick(f func(ioStreams util.IOStreams, prompt string, items []string) (string, error)) {
+	pick = f
+}
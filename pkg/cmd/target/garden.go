@@ -24,9 +24,13 @@ func NewCmdTargetGarden(f util.Factory, ioStreams util.IOStreams) *cobra.Command
 	cmd := &cobra.Command{
 		Use:   "garden",
 		Short: "Target a garden",
-		Long:  "Target a garden to set the scope for the next operations",
+		Long: `Target a garden to set the scope for the next operations.
+If no garden name is provided and the input is a terminal, the garden can be selected interactively.`,
 		Example: `# target garden with name my-garden
-gardenctl target garden my-garden`,
+gardenctl target garden my-garden
+
+# select a garden interactively
+gardenctl target garden`,
 		ValidArgsFunction: validTargetFunctionWrapper(f, ioStreams, TargetKindGarden),
 		RunE:              base.WrapRunE(o, f),
 	}
@@ -0,0 +1,187 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package target
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gardener/gardenctl-v2/internal/util"
+	"github.com/gardener/gardenctl-v2/pkg/cmd/base"
+	"github.com/gardener/gardenctl-v2/pkg/target"
+)
+
+// NewCmdTargetBack returns a new target back command.
+func NewCmdTargetBack(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &TargetOptions{
+		Kind: TargetKindBack,
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "back",
+		Short: "Target the previous target of the session history",
+		Long: `Target the previous target of the session history. The previous target is validated again before it is set.
+Instead of this subcommand, the argument "-" can be passed to the target command. Unlike "cd -", it does not switch
+between the last two targets, but goes back one step in the session history each time.`,
+		Example: `# go back to the previous target
+gardenctl target back
+
+# go back one step in the session history, repeat it to go further back
+gardenctl target -`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	return cmd
+}
+
+// NewCmdTargetForward returns a new target forward command.
+func NewCmdTargetForward(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &TargetOptions{
+		Kind: TargetKindForward,
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "forward",
+		Short: "Target the next target of the session history",
+		Long:  "Target the next target of the session history after going back. The next target is validated again before it is set.",
+		Example: `# undo "gardenctl target back"
+gardenctl target forward`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	return cmd
+}
+
+// NewCmdTargetHistory returns a new target history command.
+func NewCmdTargetHistory(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
+	o := &TargetHistoryOptions{
+		Options: base.Options{
+			IOStreams: ioStreams,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Print the target history of the current session",
+		Long:  "Print the target history of the current session. The current target is marked with an asterisk.",
+		Example: `# list the targets of the current session
+gardenctl target history
+
+# list the targets of the current session in JSON format
+gardenctl target history -o json`,
+		Args: cobra.NoArgs,
+		RunE: base.WrapRunE(o, f),
+	}
+
+	o.AddFlags(cmd.Flags())
+	o.RegisterCompletionsForOutputFlag(cmd)
+
+	return cmd
+}
+
+// TargetHistoryOptions is a struct to support history command.
+type TargetHistoryOptions struct {
+	base.Options
+	// History is the target history of the current session
+	History *target.History
+}
+
+// Complete adapts from the command line args to the data required.
+func (o *TargetHistoryOptions) Complete(f util.Factory, _ *cobra.Command, _ []string) error {
+	manager, err := f.Manager()
+	if err != nil {
+		return err
+	}
+
+	o.History, err = manager.TargetHistory()
+	if err != nil {
+		return fmt.Errorf("failed to read target history: %w", err)
+	}
+
+	return nil
+}
+
+// Run executes the command.
+func (o *TargetHistoryOptions) Run(_ util.Factory) error {
+	if o.Output != "" {
+		return o.PrintObject(o.History)
+	}
+
+	if len(o.History.Targets) == 0 {
+		fmt.Fprintln(o.IOStreams.Out, "No targets in history")
+		return nil
+	}
+
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Current", Type: "string"},
+			{Name: "Index", Type: "integer"},
+			{Name: "Garden", Type: "string"},
+			{Name: "Project", Type: "string"},
+			{Name: "Seed", Type: "string"},
+			{Name: "Shoot", Type: "string"},
+			{Name: "Control Plane", Type: "boolean"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+
+	for i, t := range o.History.Targets {
+		current := ""
+		if i == o.History.Current {
+			current = "*"
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{current, i, t.GardenName(), t.ProjectName(), t.SeedName(), t.ShootName(), t.ControlPlane()},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+
+	return printer.PrintObj(table, o.IOStreams.Out)
+}
+
+// describeTarget returns a human readable description of a target.
+func describeTarget(t target.Target) string {
+	var parts []string
+
+	if t.GardenName() != "" {
+		parts = append(parts, fmt.Sprintf("garden %q", t.GardenName()))
+	}
+
+	if t.ProjectName() != "" {
+		parts = append(parts, fmt.Sprintf("project %q", t.ProjectName()))
+	}
+
+	if t.SeedName() != "" {
+		parts = append(parts, fmt.Sprintf("seed %q", t.SeedName()))
+	}
+
+	if t.ShootName() != "" {
+		parts = append(parts, fmt.Sprintf("shoot %q", t.ShootName()))
+	}
+
+	if t.ControlPlane() {
+		parts = append(parts, "control plane")
+	}
+
+	if len(parts) == 0 {
+		return "empty target"
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -25,12 +25,16 @@ func NewCmdTargetProject(f util.Factory, ioStreams util.IOStreams) *cobra.Comman
 	cmd := &cobra.Command{
 		Use:   "project",
 		Short: "Target a project",
-		Long:  "Target a project to set the scope for the next operations",
+		Long: `Target a project to set the scope for the next operations.
+If no project name is provided and the input is a terminal, the project can be selected interactively.`,
 		Example: `# target project with name my-project of currently selected garden
 gardenctl target project my-project
 
 # target project with name my-project of garden my-garden
-gardenctl target project my-project --garden my-garden`,
+gardenctl target project my-project --garden my-garden
+
+# select a project interactively
+gardenctl target project`,
 		ValidArgsFunction: validTargetFunctionWrapper(f, ioStreams, TargetKindProject),
 		RunE:              base.WrapRunE(o, f),
 	}
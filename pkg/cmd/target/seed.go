@@ -25,12 +25,16 @@ func NewCmdTargetSeed(f util.Factory, ioStreams util.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "seed",
 		Short: "Target a seed",
-		Long:  "Target a seed to set the scope for the next operations",
+		Long: `Target a seed to set the scope for the next operations.
+If no seed name is provided and the input is a terminal, the seed can be selected interactively.`,
 		Example: `# target seed with name my-seed of currently selected garden
 gardenctl target seed my-seed
 
 # target seed with name my-seed of garden my-garden
-gardenctl target seed my-seed --garden my-garden`,
+gardenctl target seed my-seed --garden my-garden
+
+# select a seed interactively
+gardenctl target seed`,
 		ValidArgsFunction: validTargetFunctionWrapper(f, ioStreams, TargetKindSeed),
 		RunE:              base.WrapRunE(o, f),
 	}
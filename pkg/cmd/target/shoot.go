@@ -25,12 +25,16 @@ func NewCmdTargetShoot(f util.Factory, ioStreams util.IOStreams) *cobra.Command
 	cmd := &cobra.Command{
 		Use:   "shoot",
 		Short: "Target a shoot",
-		Long:  "Target a shoot to set the scope for the next operations",
+		Long: `Target a shoot to set the scope for the next operations.
+If no shoot name is provided and the input is a terminal, the shoot can be selected interactively.`,
 		Example: `# target shoot with name my-shoot of currently selected project
 gardenctl target shoot my-shoot
 
 # target shoot with name my-shoot of project my-project
-gardenctl target shoot my-shoot --garden my-garden --project my-project`,
+gardenctl target shoot my-shoot --garden my-garden --project my-project
+
+# select a shoot interactively
+gardenctl target shoot`,
 		ValidArgsFunction: validTargetFunctionWrapper(f, ioStreams, TargetKindShoot),
 		RunE:              base.WrapRunE(o, f),
 	}
@@ -36,8 +36,15 @@ gardenctl target --garden my-garden --project my-project
 gardenctl target shoot my-shoot
 
 # Target shoot control-plane using values that match a pattern defined for a specific garden
-gardenctl target value/that/matches/pattern --control-plane`,
-		RunE: base.WrapRunE(o, f),
+gardenctl target value/that/matches/pattern --control-plane
+
+# go back one step in the session history, repeat it to go further back
+gardenctl target -
+
+# target the bookmark "prod", see "gardenctl target bookmark --help"
+gardenctl target @prod`,
+		ValidArgsFunction: validBookmarkArgsFunction(f, ioStreams),
+		RunE:              base.WrapRunE(o, f),
 	}
 
 	cmd.AddCommand(NewCmdTargetGarden(f, ioStreams))
@@ -45,6 +52,10 @@ gardenctl target value/that/matches/pattern --control-plane`,
 	cmd.AddCommand(NewCmdTargetShoot(f, ioStreams))
 	cmd.AddCommand(NewCmdTargetSeed(f, ioStreams))
 	cmd.AddCommand(NewCmdTargetControlPlane(f, ioStreams))
+	cmd.AddCommand(NewCmdTargetBack(f, ioStreams))
+	cmd.AddCommand(NewCmdTargetForward(f, ioStreams))
+	cmd.AddCommand(NewCmdTargetHistory(f, ioStreams))
+	cmd.AddCommand(NewCmdBookmark(f, ioStreams))
 
 	cmd.AddCommand(NewCmdUnset(f, ioStreams))
 	cmd.AddCommand(NewCmdView(f, ioStreams))
@@ -67,8 +78,22 @@ const (
 	TargetKindShoot        TargetKind = "shoot"
 	TargetKindPattern      TargetKind = "pattern"
 	TargetKindControlPlane TargetKind = "control-plane"
+	TargetKindBack         TargetKind = "back"
+	TargetKindForward      TargetKind = "forward"
+	TargetKindBookmark     TargetKind = "bookmark"
+)
+
+var (
+	// isTerminal checks if the interactive selection can be used for the given input stream
+	isTerminal = util.IsTerminal
+	// pick lets the user select a target name interactively
+	pick = util.Pick
 )
 
+// previousTargetArg is the argument that can be used instead of the back subcommand. Like back, it goes one step back
+// in the session history, i.e. repeating it does not switch between two targets.
+const previousTargetArg = "-"
+
 type cobraValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
 
 func validTargetFunctionWrapper(f util.Factory, ioStreams util.IOStreams, kind TargetKind) cobraValidArgsFunction {
@@ -102,6 +127,8 @@ func validTargetArgsFunction(f util.Factory, kind TargetKind) ([]string, error)
 		result, err = manager.SeedNames(ctx)
 	case TargetKindShoot:
 		result, err = manager.ShootNames(ctx)
+	case TargetKindBookmark:
+		result, err = manager.BookmarkNames()
 	}
 
 	return result, err
@@ -129,11 +156,20 @@ func NewTargetOptions(ioStreams util.IOStreams) *TargetOptions {
 // Complete adapts from the command line args to the data required.
 func (o *TargetOptions) Complete(f util.Factory, _ *cobra.Command, args []string) error {
 	if len(args) > 0 {
-		if o.Kind == "" {
+		arg := strings.TrimSpace(args[0])
+
+		switch {
+		case o.Kind == "" && arg == previousTargetArg:
+			o.Kind = TargetKindBack
+		case o.Kind == "" && strings.HasPrefix(arg, bookmarkPrefix):
+			o.Kind = TargetKindBookmark
+			o.TargetName = strings.TrimPrefix(arg, bookmarkPrefix)
+		case o.Kind == "":
 			o.Kind = TargetKindPattern
+			o.TargetName = arg
+		default:
+			o.TargetName = arg
 		}
-
-		o.TargetName = strings.TrimSpace(args[0])
 	}
 
 	tf := f.TargetFlags()
@@ -166,13 +202,55 @@ func (o *TargetOptions) Complete(f util.Factory, _ *cobra.Command, args []string
 		}
 	}
 
+	if o.TargetName == "" && o.isSelectable() && isTerminal(o.IOStreams.In) {
+		name, err := o.selectTargetName(f)
+		if err != nil {
+			return err
+		}
+
+		o.TargetName = name
+	}
+
 	return nil
 }
 
+// isSelectable returns true if the target name of the kind can be selected interactively.
+func (o *TargetOptions) isSelectable() bool {
+	switch o.Kind {
+	case TargetKindGarden, TargetKindProject, TargetKindSeed, TargetKindShoot:
+		return true
+	default:
+		return false
+	}
+}
+
+// selectTargetName lets the user select the target name with an interactive fuzzy finder.
+func (o *TargetOptions) selectTargetName(f util.Factory) (string, error) {
+	names, err := validTargetArgsFunction(f, o.Kind)
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s names: %w", o.Kind, err)
+	}
+
+	if len(names) == 0 {
+		return "", fmt.Errorf("no %s found to select from", o.Kind)
+	}
+
+	name, err := pick(o.IOStreams, fmt.Sprintf("%s> ", o.Kind), names)
+	if err != nil {
+		if errors.Is(err, util.ErrSelectionAborted) {
+			return "", target.ErrAborted
+		}
+
+		return "", err
+	}
+
+	return name, nil
+}
+
 // Validate validates the provided options.
 func (o *TargetOptions) Validate() error {
 	switch o.Kind {
-	case TargetKindControlPlane:
+	case TargetKindControlPlane, TargetKindBack, TargetKindForward:
 		// valid
 	default:
 		if o.TargetName == "" {
@@ -190,7 +268,11 @@ func (o *TargetOptions) Run(f util.Factory) error {
 		return err
 	}
 
-	askForConfirmation := f.TargetFlags().ShootName() != "" || o.Kind == TargetKindShoot
+	askForConfirmation := f.TargetFlags().ShootName() != "" ||
+		o.Kind == TargetKindShoot ||
+		o.Kind == TargetKindBack ||
+		o.Kind == TargetKindForward ||
+		o.Kind == TargetKindBookmark
 	handler := ac.NewAccessRestrictionHandler(o.IOStreams.In, o.IOStreams.Out, askForConfirmation)
 	ctx := ac.WithAccessRestrictionHandler(f.Context(), handler)
 
@@ -207,6 +289,12 @@ func (o *TargetOptions) Run(f util.Factory) error {
 		err = manager.TargetMatchPattern(ctx, f.TargetFlags(), o.TargetName)
 	case TargetKindControlPlane:
 		err = manager.TargetControlPlane(ctx)
+	case TargetKindBack:
+		err = manager.TargetBack(ctx)
+	case TargetKindForward:
+		err = manager.TargetForward(ctx)
+	case TargetKindBookmark:
+		err = manager.TargetBookmark(ctx, o.TargetName)
 	}
 
 	if err != nil {
@@ -223,9 +311,14 @@ func (o *TargetOptions) Run(f util.Factory) error {
 	}
 
 	if o.Output == "" {
-		if o.Kind == TargetKindControlPlane {
+		switch o.Kind {
+		case TargetKindControlPlane:
 			fmt.Fprintf(o.IOStreams.Out, "Successfully targeted control plane of shoot %q\n", currentTarget.ShootName())
-		} else if o.Kind != "" {
+		case TargetKindBack, TargetKindForward:
+			fmt.Fprintf(o.IOStreams.Out, "Successfully targeted %s\n", describeTarget(currentTarget))
+		case "":
+			// nothing has been targeted
+		default:
 			fmt.Fprintf(o.IOStreams.Out, "Successfully targeted %s %q\n", o.Kind, o.TargetName)
 		}
 	}
@@ -8,11 +8,14 @@ package target_test
 
 import (
 	"fmt"
+	"io"
+	"path/filepath"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -101,6 +104,7 @@ var _ = Describe("Target Command", func() {
 		clientProvider = clientmocks.NewMockProvider(ctrl)
 		targetProvider = internalfake.NewFakeTargetProvider(target.NewTarget("", "", "", ""))
 		factory = internalfake.NewFakeFactory(cfg, nil, clientProvider, targetProvider)
+		factory.SessionDirectory = GinkgoT().TempDir()
 	})
 
 	JustBeforeEach(func() {
@@ -215,6 +219,62 @@ var _ = Describe("Target Command", func() {
 			Expect(currentTarget.ShootName()).To(Equal(shootName))
 		})
 
+		Context("when no name is provided", func() {
+			var items []string
+
+			BeforeEach(func() {
+				items = nil
+
+				cmdtarget.SetIsTerminal(func(io.Reader) bool { return true })
+				cmdtarget.SetPick(func(_ util.IOStreams, prompt string, names []string) (string, error) {
+					Expect(prompt).To(Equal("shoot> "))
+					items = names
+
+					return names[0], nil
+				})
+			})
+
+			AfterEach(func() {
+				cmdtarget.SetIsTerminal(util.IsTerminal)
+				cmdtarget.SetPick(util.Pick)
+			})
+
+			It("should select the shoot interactively", func() {
+				// user has already targeted a garden and project
+				targetProvider.Target = target.NewTarget(gardenName, projectName, "", "")
+				cmd := cmdtarget.NewCmdTargetShoot(factory, streams)
+
+				// run command
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(items).To(Equal([]string{shootName}))
+				Expect(out.String()).To(ContainSubstring("Successfully targeted shoot %q\n", shootName))
+
+				currentTarget, err := targetProvider.Read()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(currentTarget.ShootName()).To(Equal(shootName))
+			})
+
+			It("should abort if the selection is aborted", func() {
+				targetProvider.Target = target.NewTarget(gardenName, projectName, "", "")
+				cmdtarget.SetPick(func(util.IOStreams, string, []string) (string, error) {
+					return "", util.ErrSelectionAborted
+				})
+				cmd := cmdtarget.NewCmdTargetShoot(factory, streams)
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(target.ErrAborted))
+				Expect(targetProvider.Target.ShootName()).To(BeEmpty())
+			})
+
+			It("should require a name argument if the input is not a terminal", func() {
+				cmdtarget.SetIsTerminal(func(io.Reader) bool { return false })
+				targetProvider.Target = target.NewTarget(gardenName, projectName, "", "")
+				cmd := cmdtarget.NewCmdTargetShoot(factory, streams)
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(`target kind "shoot" requires a name argument`))
+				Expect(items).To(BeNil())
+			})
+		})
+
 		Context("when the shoot has access restrictions", func() {
 			BeforeEach(func() {
 				shoot.Spec.AccessRestrictions = []gardencorev1beta1.AccessRestrictionWithOptions{
@@ -240,6 +300,141 @@ var _ = Describe("Target Command", func() {
 				Expect(out.String()).To(MatchRegexp(`(?s)Access strictly prohibited.*Do you want to continue\?.*Successfully targeted shoot %q\n`, shootName))
 			})
 		})
+		Context("when navigating the target history", func() {
+			JustBeforeEach(func() {
+				// user has already targeted a garden and a project
+				targetProvider.Target = target.NewTarget(gardenName, "", "", "")
+				setupStreams, _, _, _ := util.NewTestIOStreams()
+				cmd := cmdtarget.NewCmdTargetProject(factory, setupStreams)
+				Expect(cmd.RunE(cmd, []string{projectName})).To(Succeed())
+			})
+
+			It("should be able to go back to the previous target", func() {
+				cmd := cmdtarget.NewCmdTarget(factory, streams)
+
+				// run command
+				Expect(cmd.RunE(cmd, []string{"-"})).To(Succeed())
+				Expect(out.String()).To(ContainSubstring("Successfully targeted garden %q\n", gardenName))
+
+				currentTarget, err := targetProvider.Read()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(currentTarget.GardenName()).To(Equal(gardenName))
+				Expect(currentTarget.ProjectName()).To(BeEmpty())
+			})
+
+			It("should be able to go forward after going back", func() {
+				cmd := cmdtarget.NewCmdTargetBack(factory, streams)
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				cmd = cmdtarget.NewCmdTargetForward(factory, streams)
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(out.String()).To(ContainSubstring("Successfully targeted garden %q, project %q\n", gardenName, projectName))
+
+				currentTarget, err := targetProvider.Read()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(currentTarget.ProjectName()).To(Equal(projectName))
+			})
+
+			It("should fail to go forward without next target", func() {
+				cmd := cmdtarget.NewCmdTargetForward(factory, streams)
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(target.ErrNoNextTarget))
+			})
+
+			It("should print the target history", func() {
+				cmd := cmdtarget.NewCmdTargetHistory(factory, streams)
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(out.String()).To(MatchRegexp(`CURRENT\s+INDEX\s+GARDEN\s+PROJECT\s+SEED\s+SHOOT\s+CONTROL PLANE\n\s+0\s+%s\s+false\n\*\s+1\s+%s\s+%s\s+false\n`, gardenName, gardenName, projectName))
+			})
+
+			It("should print the target history as json", func() {
+				cmd := cmdtarget.NewCmdTargetHistory(factory, streams)
+				Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(out.String()).To(MatchJSON(fmt.Sprintf(`{"targets":[{"garden":%q},{"garden":%q,"project":%q}],"current":1}`, gardenName, gardenName, projectName)))
+			})
+		})
+		Context("when using bookmarks", func() {
+			BeforeEach(func() {
+				cfg.Filename = filepath.Join(GinkgoT().TempDir(), "gardenctl-v2.yaml")
+				cfg.Bookmarks = []config.Bookmark{{
+					Name:    "prod",
+					Garden:  gardenName,
+					Project: projectName,
+					Shoot:   shootName,
+				}}
+			})
+
+			It("should be able to target a bookmark", func() {
+				cmd := cmdtarget.NewCmdTarget(factory, streams)
+
+				// run command
+				Expect(cmd.RunE(cmd, []string{"@prod"})).To(Succeed())
+				Expect(out.String()).To(ContainSubstring("Successfully targeted bookmark %q\n", "prod"))
+
+				currentTarget, err := targetProvider.Read()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(currentTarget).To(Equal(target.NewTarget(gardenName, projectName, "", shootName)))
+			})
+
+			It("should fail to target an unknown bookmark", func() {
+				cmd := cmdtarget.NewCmdTarget(factory, streams)
+
+				Expect(cmd.RunE(cmd, []string{"@dev"})).To(MatchError(`bookmark "dev" is not defined in gardenctl configuration`))
+			})
+
+			It("should bookmark the current target", func() {
+				targetProvider.Target = target.NewTarget(gardenName, projectName, "", shootName).WithControlPlane(true)
+				cmd := cmdtarget.NewCmdBookmarkAdd(factory, streams)
+
+				Expect(cmd.RunE(cmd, []string{"cp"})).To(Succeed())
+				Expect(out.String()).To(Equal(fmt.Sprintf("Successfully bookmarked garden %q, project %q, shoot %q, control plane as %q\n", gardenName, projectName, shootName, "cp")))
+
+				loaded, err := config.LoadFromFile(cfg.Filename)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(loaded.Bookmarks).To(ConsistOf(cfg.Bookmarks[0], config.Bookmark{
+					Name:         "cp",
+					Garden:       gardenName,
+					Project:      projectName,
+					Shoot:        shootName,
+					ControlPlane: true,
+				}))
+			})
+
+			It("should not overwrite an existing bookmark by default", func() {
+				targetProvider.Target = target.NewTarget(gardenName, "", "", "")
+				cmd := cmdtarget.NewCmdBookmarkAdd(factory, streams)
+
+				Expect(cmd.RunE(cmd, []string{"prod"})).To(MatchError(`bookmark "prod" already exists, use --overwrite to replace it`))
+
+				Expect(cmd.Flags().Set("overwrite", "true")).To(Succeed())
+				Expect(cmd.RunE(cmd, []string{"prod"})).To(Succeed())
+				Expect(cfg.Bookmarks).To(Equal([]config.Bookmark{{Name: "prod", Garden: gardenName}}))
+			})
+
+			It("should fail to bookmark without targeted garden", func() {
+				cmd := cmdtarget.NewCmdBookmarkAdd(factory, streams)
+
+				Expect(cmd.RunE(cmd, []string{"empty"})).To(MatchError(target.ErrNoGardenTargeted))
+			})
+
+			It("should list the bookmarks", func() {
+				cmd := cmdtarget.NewCmdBookmarkList(factory, streams)
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				Expect(out.String()).To(MatchRegexp(`NAME\s+GARDEN\s+PROJECT\s+SEED\s+SHOOT\s+CONTROL PLANE\nprod\s+%s\s+%s\s+%s\s+false\n`, gardenName, projectName, shootName))
+			})
+
+			It("should delete a bookmark", func() {
+				cmd := cmdtarget.NewCmdBookmarkDelete(factory, streams)
+
+				Expect(cmd.RunE(cmd, []string{"prod"})).To(Succeed())
+				Expect(out.String()).To(Equal("Successfully deleted bookmark \"prod\"\n"))
+				Expect(cfg.Bookmarks).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("Completion", func() {
@@ -320,6 +515,19 @@ var _ = Describe("Target Command", func() {
 				Expect(values).To(Equal([]string{cfg.Gardens[1].Name, gardenName}))
 			})
 
+			It("should return all bookmark names", func() {
+				cfg.Bookmarks = []config.Bookmark{{Name: "prod", Garden: gardenName}, {Name: "dev", Garden: gardenName}}
+
+				values, err := cmdtarget.ValidTargetArgsFunction(factory, cmdtarget.TargetKindBookmark)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(Equal([]string{"prod", "dev"}))
+
+				cmd := cmdtarget.NewCmdTarget(factory, streams)
+				values, directive := cmd.ValidArgsFunction(cmd, nil, "@d")
+				Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+				Expect(values).To(Equal([]string{"@dev"}))
+			})
+
 			It("should return all project names", func() {
 				targetProvider.Target = target.NewTarget(gardenName, "", "", "")
 
@@ -13,8 +13,10 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
@@ -33,6 +35,46 @@ type Config struct {
 	Gardens []Garden `json:"gardens"`
 	// Provider holds provider-specific configuration
 	Provider *ProviderConfig `json:"provider,omitempty"`
+	// Bookmarks is a list of named targets
+	// +optional
+	Bookmarks []Bookmark `json:"bookmarks,omitempty"`
+	// Session holds the configuration of the gardenctl session directories
+	// +optional
+	Session *SessionConfig `json:"session,omitempty"`
+}
+
+// DefaultSessionTTL is the default time after which inactive sessions are removed.
+const DefaultSessionTTL = 7 * 24 * time.Hour
+
+// SessionConfig holds the configuration of the gardenctl session directories.
+type SessionConfig struct {
+	// TTL is the time after which inactive session directories, kubeconfig files and provider configuration
+	// directories are removed. Defaults to 168h
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// AutoClean defines if inactive sessions are removed automatically when gardenctl is executed
+	// +optional
+	AutoClean bool `json:"autoClean,omitempty"`
+}
+
+// Bookmark is a named target that can be used to quickly switch between frequently used targets.
+type Bookmark struct {
+	// Name is a unique identifier of this Bookmark
+	Name string `json:"name"`
+	// Garden is the name of the targeted Garden
+	Garden string `json:"garden"`
+	// Project is the name of the targeted Project
+	// +optional
+	Project string `json:"project,omitempty"`
+	// Seed is the name of the targeted Seed
+	// +optional
+	Seed string `json:"seed,omitempty"`
+	// Shoot is the name of the targeted Shoot
+	// +optional
+	Shoot string `json:"shoot,omitempty"`
+	// ControlPlane defines if the control plane of the Shoot is targeted
+	// +optional
+	ControlPlane bool `json:"controlPlane,omitempty"`
 }
 
 // Garden represents one garden cluster.
@@ -55,6 +97,63 @@ type Garden struct {
 	// AccessRestrictions is a list of access restriction definitions
 	// +optional
 	AccessRestrictions []ac.AccessRestriction `json:"accessRestrictions,omitempty"`
+	// SSHKeyType is the algorithm of the SSH keypairs generated for bastions of this Garden.
+	// Valid values are rsa, ed25519 and ecdsa. Defaults to rsa. The --key-type flag takes precedence
+	// +optional
+	SSHKeyType string `json:"sshKeyType,omitempty"`
+	// RecordSSHSessions makes the recording of interactive SSH sessions to nodes of this Garden mandatory.
+	// The gardenctl ssh command refuses to run if the session cannot be recorded, which also applies to ssh exec, ssh proxy and scp
+	// +optional
+	RecordSSHSessions bool `json:"recordSSHSessions,omitempty"`
+	// SSHCertificate configures the signing of the public SSH keys used for bastions of this Garden with an SSH
+	// certificate authority. The resulting short-lived certificate is presented to the bastion and the nodes
+	// +optional
+	SSHCertificate *SSHCertificateConfig `json:"sshCertificate,omitempty"`
+}
+
+// DefaultSSHCertificateTTL is the default validity period of signed SSH certificates.
+const DefaultSSHCertificateTTL = time.Hour
+
+// SSHCertificateConfig holds the configuration of the SSH certificate authority that signs the public SSH keys.
+// Exactly one of SignerCommand and CAKeyFile must be set.
+type SSHCertificateConfig struct {
+	// SignerCommand is an external command, followed by its arguments, that signs the public SSH key.
+	// The public key is passed on stdin, the principals and the TTL are passed in the environment variables
+	// GARDENCTL_SSH_CERTIFICATE_PRINCIPALS (comma separated) and GARDENCTL_SSH_CERTIFICATE_TTL.
+	// The command must print the certificate in the OpenSSH authorized_keys format to stdout
+	// +optional
+	SignerCommand []string `json:"signerCommand,omitempty"`
+	// CAKeyFile is the path of an unencrypted private key file of the SSH certificate authority
+	// +optional
+	CAKeyFile string `json:"caKeyFile,omitempty"`
+	// Principals are the names for which the certificate is valid. Defaults to the SSH users of the bastion and the nodes
+	// +optional
+	Principals []string `json:"principals,omitempty"`
+	// TTL is the validity period of the certificate. Defaults to 1h
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// CertificateTTL returns the validity period of signed SSH certificates.
+func (c *SSHCertificateConfig) CertificateTTL() time.Duration {
+	if c.TTL == nil || c.TTL.Duration <= 0 {
+		return DefaultSSHCertificateTTL
+	}
+
+	return c.TTL.Duration
+}
+
+// Validate validates the SSH certificate authority configuration.
+func (c *SSHCertificateConfig) Validate() error {
+	if len(c.SignerCommand) == 0 && c.CAKeyFile == "" {
+		return errors.New("either signerCommand or caKeyFile must be set")
+	}
+
+	if len(c.SignerCommand) > 0 && c.CAKeyFile != "" {
+		return errors.New("signerCommand and caKeyFile are mutually exclusive")
+	}
+
+	return nil
 }
 
 // ProviderConfig represents provider-specific configuration options.
@@ -159,6 +258,20 @@ func (config *Config) SymlinkTargetKubeconfig() bool {
 	return config.LinkKubeconfig == nil || *config.LinkKubeconfig
 }
 
+// SessionTTL returns the time after which inactive sessions are removed.
+func (config *Config) SessionTTL() time.Duration {
+	if config.Session == nil || config.Session.TTL == nil || config.Session.TTL.Duration <= 0 {
+		return DefaultSessionTTL
+	}
+
+	return config.Session.TTL.Duration
+}
+
+// SessionAutoClean indicates if inactive sessions are removed automatically.
+func (config *Config) SessionAutoClean() bool {
+	return config.Session != nil && config.Session.AutoClean
+}
+
 // Save updates a gardenctl config file with the values passed via Config struct.
 func (config *Config) Save() error {
 	dir := filepath.Dir(config.Filename)
@@ -230,6 +343,42 @@ func (config *Config) Garden(name string) (*Garden, error) {
 	return nil, fmt.Errorf("garden %q is not defined in gardenctl configuration", name)
 }
 
+// IndexOfBookmark returns the index of the Bookmark with the given name in the configured Bookmarks slice.
+// If no Bookmark with this name is found it returns -1.
+func (config *Config) IndexOfBookmark(name string) (int, bool) {
+	for i, b := range config.Bookmarks {
+		if b.Name == name {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// BookmarkNames returns a slice containing the names of the configured Bookmarks.
+func (config *Config) BookmarkNames() []string {
+	names := []string{}
+	for _, b := range config.Bookmarks {
+		names = append(names, b.Name)
+	}
+
+	return names
+}
+
+// Bookmark returns the Bookmark with the given name from the list of configured Bookmarks.
+func (config *Config) Bookmark(name string) (*Bookmark, error) {
+	if name == "" {
+		return nil, fmt.Errorf("bookmark name cannot be empty")
+	}
+
+	i, ok := config.IndexOfBookmark(name)
+	if !ok {
+		return nil, fmt.Errorf("bookmark %q is not defined in gardenctl configuration", name)
+	}
+
+	return &config.Bookmarks[i], nil
+}
+
 // ClientConfig returns a deferred loading client config for a configured garden cluster.
 func (config *Config) ClientConfig(name string) (clientcmd.ClientConfig, error) {
 	garden, err := config.Garden(name)
@@ -10,9 +10,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardenctl-v2/pkg/config"
@@ -148,6 +151,77 @@ var _ = Describe("Config", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	Describe("#Bookmark", func() {
+		BeforeEach(func() {
+			cfg.Bookmarks = []config.Bookmark{
+				{Name: "prod", Garden: clusterIdentity1, Project: project, Shoot: shoot, ControlPlane: true},
+				{Name: "dev", Garden: clusterIdentity2},
+			}
+		})
+
+		It("should find bookmark by name", func() {
+			bookmark, err := cfg.Bookmark("prod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*bookmark).To(Equal(cfg.Bookmarks[0]))
+		})
+
+		It("should throw an error if bookmark not found", func() {
+			_, err := cfg.Bookmark("foobar")
+			Expect(err).To(MatchError(`bookmark "foobar" is not defined in gardenctl configuration`))
+		})
+
+		It("should return the bookmark names", func() {
+			Expect(cfg.BookmarkNames()).To(Equal([]string{"prod", "dev"}))
+		})
+
+		It("should save and load bookmarks", func() {
+			cfg.Filename = filepath.Join(gardenHomeDir, "gardenctl-v2.yaml")
+			Expect(cfg.Save()).To(Succeed())
+
+			loaded, err := config.LoadFromFile(cfg.Filename)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.Bookmarks).To(Equal(cfg.Bookmarks))
+		})
+	})
+
+	Describe("#Session", func() {
+		It("should use the default TTL and disable the automatic cleanup if not configured", func() {
+			Expect(cfg.SessionTTL()).To(Equal(config.DefaultSessionTTL))
+			Expect(cfg.SessionAutoClean()).To(BeFalse())
+		})
+
+		It("should save and load the session configuration", func() {
+			cfg.Filename = filepath.Join(gardenHomeDir, "gardenctl-v2.yaml")
+			cfg.Session = &config.SessionConfig{
+				TTL:       &metav1.Duration{Duration: 24 * time.Hour},
+				AutoClean: true,
+			}
+			Expect(cfg.Save()).To(Succeed())
+
+			loaded, err := config.LoadFromFile(cfg.Filename)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.SessionTTL()).To(Equal(24 * time.Hour))
+			Expect(loaded.SessionAutoClean()).To(BeTrue())
+		})
+	})
+
+	Describe("#SSHCertificate", func() {
+		It("should default the TTL", func() {
+			Expect((&config.SSHCertificateConfig{}).CertificateTTL()).To(Equal(config.DefaultSSHCertificateTTL))
+			Expect((&config.SSHCertificateConfig{TTL: &metav1.Duration{Duration: 5 * time.Minute}}).CertificateTTL()).To(Equal(5 * time.Minute))
+		})
+
+		DescribeTable("should validate the signer",
+			func(c config.SSHCertificateConfig, matcher types.GomegaMatcher) {
+				Expect(c.Validate()).To(matcher)
+			},
+			Entry("signer command", config.SSHCertificateConfig{SignerCommand: []string{"sign"}}, Succeed()),
+			Entry("CA key file", config.SSHCertificateConfig{CAKeyFile: "/ca"}, Succeed()),
+			Entry("no signer", config.SSHCertificateConfig{}, MatchError("either signerCommand or caKeyFile must be set")),
+			Entry("both signers", config.SSHCertificateConfig{SignerCommand: []string{"sign"}, CAKeyFile: "/ca"}, MatchError("signerCommand and caKeyFile are mutually exclusive")),
+		)
+	})
+
 	DescribeTable("saving and loading the linkKubeconfig configuration", func(actVal *bool, envVal string, expVal *bool) {
 		envKey := "GCTL_LINK_KUBECONFIG"
 		filename := filepath.Join(gardenHomeDir, "gardenctl-v2.yaml")
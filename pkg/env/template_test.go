@@ -239,6 +239,28 @@ Remove-Item -ErrorAction SilentlyContinue Env:\AZURE_CONFIG_DIR;
 		)
 	})
 
+	Describe("parsing the proxy template", func() {
+		const proxyURL = "socks5h://127.0.0.1:1080"
+
+		BeforeEach(func() {
+			filenames = append(filenames, "proxy")
+		})
+
+		JustBeforeEach(func() {
+			data["proxyURL"] = proxyURL
+		})
+
+		DescribeTable("executing the shell template",
+			func(shell string, format string) {
+				Expect(t.ExecuteTemplate(out, shell, data)).To(Succeed())
+				Expect(out.String()).To(Equal(fmt.Sprintf(format, proxyURL)))
+			},
+			Entry("shell is bash", "bash", "export HTTPS_PROXY='%[1]s';\nexport ALL_PROXY='%[1]s';\n"),
+			Entry("shell is fish", "fish", "set -gx HTTPS_PROXY '%[1]s';\nset -gx ALL_PROXY '%[1]s';\n"),
+			Entry("shell is powershell", "powershell", "$Env:HTTPS_PROXY = '%[1]s';\n$Env:ALL_PROXY = '%[1]s';\n"),
+		)
+	})
+
 	Describe("parsing custom templates", func() {
 		var filename string
 
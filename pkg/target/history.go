@@ -0,0 +1,124 @@
+/*
+SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package target
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// maxHistoryEntries is the maximum number of targets kept in the history of a session.
+const maxHistoryEntries = 50
+
+// History holds the targets of a gardenctl session in chronological order.
+type History struct {
+	// Targets is the list of targets, the oldest target comes first
+	Targets []Target `json:"targets"`
+	// Current is the index of the current target in Targets
+	Current int `json:"current"`
+}
+
+// UnmarshalJSON decodes the history targets into their underlying type.
+func (h *History) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Targets []*targetImpl `json:"targets"`
+		Current int           `json:"current"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	h.Targets = make([]Target, 0, len(raw.Targets))
+	for i, t := range raw.Targets {
+		if t == nil {
+			return fmt.Errorf("invalid history: target %d is empty", i)
+		}
+
+		h.Targets = append(h.Targets, t)
+	}
+
+	// the history file might have been edited, keep the current position within the targets
+	h.Current = min(max(raw.Current, -1), len(h.Targets)-1)
+
+	return nil
+}
+
+// CurrentTarget returns the target at the current position of the history.
+func (h *History) CurrentTarget() Target {
+	if h.Current < 0 || h.Current >= len(h.Targets) {
+		return nil
+	}
+
+	return h.Targets[h.Current]
+}
+
+// push discards all targets after the current position and appends the given target.
+func (h *History) push(t Target) {
+	if current := h.CurrentTarget(); current != nil && equalTargets(current, t) {
+		h.Targets = h.Targets[:h.Current+1]
+		return
+	}
+
+	if len(h.Targets) > 0 {
+		h.Targets = h.Targets[:h.Current+1]
+	}
+
+	h.Targets = append(h.Targets, t.DeepCopy())
+
+	if len(h.Targets) > maxHistoryEntries {
+		h.Targets = h.Targets[len(h.Targets)-maxHistoryEntries:]
+	}
+
+	h.Current = len(h.Targets) - 1
+}
+
+func equalTargets(a, b Target) bool {
+	return a.GardenName() == b.GardenName() &&
+		a.ProjectName() == b.ProjectName() &&
+		a.SeedName() == b.SeedName() &&
+		a.ShootName() == b.ShootName() &&
+		a.ControlPlane() == b.ControlPlane()
+}
+
+// readHistory reads the history from the given file. If the file does not
+// exist, an empty history is returned.
+func readHistory(filename string) (*History, error) {
+	history := &History{}
+
+	buf, err := os.ReadFile(filename) // #nosec G304 -- The history file is located in the session directory
+	if err != nil {
+		if os.IsNotExist(err) {
+			return history, nil
+		}
+
+		return nil, fmt.Errorf("failed to read history file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(buf, history); err != nil {
+		return nil, fmt.Errorf("failed to decode history as YAML: %w", err)
+	}
+
+	return history, nil
+}
+
+// writeHistory writes the history to the given file.
+func writeHistory(filename string, history *History) error {
+	buf, err := yaml.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed to encode history as YAML: %w", err)
+	}
+
+	if err := os.WriteFile(filename, buf, 0o600); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	return nil
+}
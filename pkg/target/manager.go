@@ -31,6 +31,8 @@ var (
 	ErrNeitherProjectNorSeedTargeted = errors.New("neither project nor seed are targeted")
 	ErrNoControlPlaneTargeted        = errors.New("no control plane targeted")
 	ErrAborted                       = errors.New("operation aborted")
+	ErrNoPreviousTarget              = errors.New("no previous target in history")
+	ErrNoNextTarget                  = errors.New("no next target in history")
 )
 
 // FlagCompletors provides a set of functions that lists suitable completion values for
@@ -47,6 +49,8 @@ type FlagCompletors interface {
 	ProjectNames(ctx context.Context) ([]string, error)
 	// GardenNames returns all identities and aliases of configured Gardens.
 	GardenNames() ([]string, error)
+	// BookmarkNames returns the names of all configured Bookmarks.
+	BookmarkNames() ([]string, error)
 }
 
 //go:generate mockgen -destination=./mocks/mock_manager.go -package=mocks github.com/gardener/gardenctl-v2/pkg/target Manager
@@ -90,6 +94,19 @@ type Manager interface {
 	// against patterns defined in gardenctl configuration. Some values may only match a subset
 	// of a pattern
 	TargetMatchPattern(ctx context.Context, tf TargetFlags, value string) error
+	// TargetBack sets the target to the previous target of the session history
+	// The restored target is validated again before it is set
+	TargetBack(ctx context.Context) error
+	// TargetForward sets the target to the next target of the session history
+	// The restored target is validated again before it is set
+	TargetForward(ctx context.Context) error
+	// TargetHistory returns the history of targets of the current session
+	TargetHistory() (*History, error)
+	// TargetBookmark sets the target to the target stored in the bookmark with the given name
+	// The bookmarked target is validated again before it is set
+	TargetBookmark(ctx context.Context, name string) error
+	// SetTarget validates all values of the given target and sets it as the current target
+	SetTarget(ctx context.Context, t Target) error
 
 	// ClientConfig returns the client config for a target
 	ClientConfig(ctx context.Context, t Target) (clientcmd.ClientConfig, error)
@@ -188,14 +205,12 @@ func (m *managerImpl) UnsetTargetGarden(ctx context.Context) (string, error) {
 		return "", ErrNoGardenTargeted
 	}
 
-	return targetedGarden, m.patchTarget(ctx, func(t *targetImpl) error {
+	return targetedGarden, m.unsetTarget(ctx, currentTarget, func(t *targetImpl) {
 		t.Garden = ""
 		t.Project = ""
 		t.Seed = ""
 		t.Shoot = ""
 		t.ControlPlaneFlag = false
-
-		return nil
 	})
 }
 
@@ -231,12 +246,10 @@ func (m *managerImpl) UnsetTargetProject(ctx context.Context) (string, error) {
 		return "", ErrNoProjectTargeted
 	}
 
-	return targetedName, m.patchTarget(ctx, func(t *targetImpl) error {
+	return targetedName, m.unsetTarget(ctx, currentTarget, func(t *targetImpl) {
 		t.Project = ""
 		t.Shoot = ""
 		t.ControlPlaneFlag = false
-
-		return nil
 	})
 }
 
@@ -272,10 +285,8 @@ func (m *managerImpl) UnsetTargetSeed(ctx context.Context) (string, error) {
 		return "", ErrNoSeedTargeted
 	}
 
-	return targetedName, m.patchTarget(ctx, func(t *targetImpl) error {
+	return targetedName, m.unsetTarget(ctx, currentTarget, func(t *targetImpl) {
 		t.Seed = ""
-
-		return nil
 	})
 }
 
@@ -311,11 +322,9 @@ func (m *managerImpl) UnsetTargetShoot(ctx context.Context) (string, error) {
 		return "", ErrNoShootTargeted
 	}
 
-	return targetedName, m.patchTarget(ctx, func(t *targetImpl) error {
+	return targetedName, m.unsetTarget(ctx, currentTarget, func(t *targetImpl) {
 		t.Shoot = ""
 		t.ControlPlaneFlag = false
-
-		return nil
 	})
 }
 
@@ -350,10 +359,8 @@ func (m *managerImpl) UnsetTargetControlPlane(ctx context.Context) error {
 		return ErrNoControlPlaneTargeted
 	}
 
-	return m.patchTarget(ctx, func(t *targetImpl) error {
+	return m.unsetTarget(ctx, currentTarget, func(t *targetImpl) {
 		t.ControlPlaneFlag = false
-
-		return nil
 	})
 }
 
@@ -417,7 +424,149 @@ func (m *managerImpl) TargetMatchPattern(ctx context.Context, tf TargetFlags, va
 	return m.updateTarget(ctx, target)
 }
 
+func (m *managerImpl) TargetBack(ctx context.Context) error {
+	return m.targetHistoryEntry(ctx, -1)
+}
+
+func (m *managerImpl) TargetForward(ctx context.Context) error {
+	return m.targetHistoryEntry(ctx, 1)
+}
+
+func (m *managerImpl) TargetHistory() (*History, error) {
+	return readHistory(m.historyFile())
+}
+
+func (m *managerImpl) TargetBookmark(ctx context.Context, name string) error {
+	bookmark, err := m.config.Bookmark(name)
+	if err != nil {
+		return err
+	}
+
+	t := NewTarget(bookmark.Garden, bookmark.Project, bookmark.Seed, bookmark.Shoot).WithControlPlane(bookmark.ControlPlane)
+
+	return m.SetTarget(ctx, t)
+}
+
+func (m *managerImpl) SetTarget(ctx context.Context, t Target) error {
+	target, err := m.rebuildTarget(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return m.updateTarget(ctx, target)
+}
+
+// targetHistoryEntry moves the current position of the history by offset and
+// sets the target found at the new position.
+func (m *managerImpl) targetHistoryEntry(ctx context.Context, offset int) error {
+	history, err := m.TargetHistory()
+	if err != nil {
+		return err
+	}
+
+	index := history.Current + offset
+	if index < 0 || index >= len(history.Targets) {
+		if offset > 0 {
+			return ErrNoNextTarget
+		}
+
+		return ErrNoPreviousTarget
+	}
+
+	target, err := m.rebuildTarget(ctx, history.Targets[index])
+	if err != nil {
+		return err
+	}
+
+	if err := m.applyTarget(ctx, target); err != nil {
+		return err
+	}
+
+	history.Current = index
+
+	return writeHistory(m.historyFile(), history)
+}
+
+// rebuildTarget uses a TargetBuilder to validate all values of the given target from scratch.
+func (m *managerImpl) rebuildTarget(ctx context.Context, t Target) (Target, error) {
+	tb, err := NewTargetBuilder(m.config, m.clientProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new target builder: %w", err)
+	}
+
+	tb.Init(NewTarget("", "", "", ""))
+
+	if t.GardenName() != "" {
+		tb.SetGarden(t.GardenName())
+	}
+
+	if t.ProjectName() != "" {
+		tb.SetProject(ctx, t.ProjectName())
+	}
+
+	if t.SeedName() != "" {
+		tb.SetSeed(ctx, t.SeedName())
+	}
+
+	if t.ShootName() != "" {
+		tb.SetShoot(ctx, t.ShootName())
+	}
+
+	if t.ControlPlane() {
+		tb.SetControlPlane(ctx)
+	}
+
+	return tb.Build()
+}
+
+// updateTarget sets the given target and records it in the session history.
 func (m *managerImpl) updateTarget(ctx context.Context, target Target) error {
+	currentTarget, err := m.targetProvider.Read()
+	if err != nil {
+		return err
+	}
+
+	// the target provider may hand out a shared instance, which is modified by applyTarget
+	previousTarget := currentTarget.DeepCopy()
+
+	if err := m.applyTarget(ctx, target); err != nil {
+		return err
+	}
+
+	history, err := m.TargetHistory()
+	if err != nil {
+		return err
+	}
+
+	if len(history.Targets) == 0 && !previousTarget.IsEmpty() {
+		history.push(previousTarget)
+	}
+
+	history.push(target)
+
+	return writeHistory(m.historyFile(), history)
+}
+
+// unsetTarget unsets values of the current target with the given function and records the result in the session history.
+func (m *managerImpl) unsetTarget(ctx context.Context, currentTarget Target, unset func(t *targetImpl)) error {
+	target := &targetImpl{
+		currentTarget.GardenName(),
+		currentTarget.ProjectName(),
+		currentTarget.SeedName(),
+		currentTarget.ShootName(),
+		currentTarget.ControlPlane(),
+	}
+
+	unset(target)
+
+	return m.updateTarget(ctx, target)
+}
+
+func (m *managerImpl) historyFile() string {
+	return filepath.Join(m.sessionDirectory, "history.yaml")
+}
+
+func (m *managerImpl) applyTarget(ctx context.Context, target Target) error {
 	return m.patchTarget(ctx, func(t *targetImpl) error {
 		t.Garden = target.GardenName()
 		t.Project = target.ProjectName()
@@ -745,6 +894,16 @@ func (m *managerImpl) GardenNames() ([]string, error) {
 	return names.List(), nil
 }
 
+// BookmarkNames returns the names of all configured Bookmarks.
+func (m *managerImpl) BookmarkNames() ([]string, error) {
+	config := m.Configuration()
+	if config == nil {
+		return nil, errors.New("could not get configuration")
+	}
+
+	return config.BookmarkNames(), nil
+}
+
 func writeRawConfig(config clientcmd.ClientConfig) ([]byte, error) {
 	rawConfig, err := config.RawConfig()
 	if err != nil {
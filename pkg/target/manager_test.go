@@ -8,6 +8,8 @@ package target_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -405,6 +407,186 @@ var _ = Describe("Target Manager", func() {
 		assertTargetProvider(targetProvider, t)
 	})
 
+	Describe("#TargetHistory", func() {
+		BeforeEach(func() {
+			Expect(os.RemoveAll(filepath.Join(sessionDir, "history.yaml"))).To(Succeed())
+		})
+
+		It("should record the previous and the new target", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, _ := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetProject(ctx, prod1Project.Name)).To(Succeed())
+
+			history, err := manager.TargetHistory()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Targets).To(HaveLen(2))
+			Expect(history.Current).To(Equal(1))
+			Expect(history.Targets[0].GardenName()).To(Equal(gardenName))
+			Expect(history.Targets[0].ProjectName()).To(BeEmpty())
+			Expect(history.CurrentTarget().ProjectName()).To(Equal(prod1Project.Name))
+		})
+
+		It("should go back and forward in the history", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetProject(ctx, prod1Project.Name)).To(Succeed())
+			Expect(manager.TargetShoot(ctx, prod1GoldenShoot.Name)).To(Succeed())
+
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", ""))
+
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, t)
+
+			Expect(manager.TargetBack(ctx)).To(MatchError(target.ErrNoPreviousTarget))
+			assertTargetProvider(targetProvider, t)
+
+			Expect(manager.TargetForward(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", ""))
+
+			Expect(manager.TargetForward(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", prod1GoldenShoot.Name))
+
+			Expect(manager.TargetForward(ctx)).To(MatchError(target.ErrNoNextTarget))
+		})
+
+		It("should discard the forward history when a new target is set", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetProject(ctx, prod1Project.Name)).To(Succeed())
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			Expect(manager.TargetSeed(ctx, seed.Name)).To(Succeed())
+
+			Expect(manager.TargetForward(ctx)).To(MatchError(target.ErrNoNextTarget))
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, t)
+
+			history, err := manager.TargetHistory()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Targets).To(HaveLen(2))
+		})
+
+		It("should record unset targets", func() {
+			t := target.NewTarget(gardenName, prod1Project.Name, "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetShoot(ctx, prod1GoldenShoot.Name)).To(Succeed())
+
+			_, err := manager.UnsetTargetShoot(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = manager.UnsetTargetGarden(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			history, err := manager.TargetHistory()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history.Targets).To(HaveLen(4))
+			Expect(history.CurrentTarget().IsEmpty()).To(BeTrue())
+
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, t)
+
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", prod1GoldenShoot.Name))
+
+			Expect(manager.TargetForward(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, t)
+		})
+
+		It("should keep the current position within the targets of an edited history", func() {
+			history := fmt.Sprintf("targets:\n- garden: %s\n- garden: %s\n  project: %s\ncurrent: 5\n", gardenName, gardenName, prod1Project.Name)
+			Expect(os.WriteFile(filepath.Join(sessionDir, "history.yaml"), []byte(history), 0o600)).To(Succeed())
+
+			t := target.NewTarget(gardenName, prod1Project.Name, "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetShoot(ctx, prod1GoldenShoot.Name)).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", prod1GoldenShoot.Name))
+
+			Expect(manager.TargetBack(ctx)).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", ""))
+		})
+
+		It("should reject a history with empty targets", func() {
+			history := fmt.Sprintf("targets:\n- garden: %s\n- null\ncurrent: 0\n", gardenName)
+			Expect(os.WriteFile(filepath.Join(sessionDir, "history.yaml"), []byte(history), 0o600)).To(Succeed())
+
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, _ := createTestManager(t, cfg, clientProvider)
+
+			_, err := manager.TargetHistory()
+			Expect(err).To(MatchError(ContainSubstring("target 1 is empty")))
+		})
+
+		It("should fail to go back without history", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetBack(ctx)).To(MatchError(target.ErrNoPreviousTarget))
+			assertTargetProvider(targetProvider, t)
+		})
+
+		It("should fail to go forward without history", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetForward(ctx)).To(MatchError(target.ErrNoNextTarget))
+			assertTargetProvider(targetProvider, t)
+		})
+	})
+
+	Describe("#SetTarget", func() {
+		It("should validate and set the complete target", func() {
+			t := target.NewTarget("", "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.SetTarget(ctx, target.NewTarget(gardenName, prod1Project.Name, "", prod1GoldenShoot.Name))).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", prod1GoldenShoot.Name))
+		})
+
+		It("should not set an invalid target", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.SetTarget(ctx, target.NewTarget(gardenName, prod1Project.Name, "", "does-not-exist"))).NotTo(Succeed())
+			assertTargetProvider(targetProvider, t)
+		})
+	})
+
+	Describe("#TargetBookmark", func() {
+		BeforeEach(func() {
+			cfg.Bookmarks = []config.Bookmark{
+				{Name: "golden", Garden: gardenName, Project: prod1Project.Name, Shoot: prod1GoldenShoot.Name, ControlPlane: true},
+				{Name: "pending", Garden: gardenName, Project: prod1Project.Name, Shoot: prod1PendingShoot.Name},
+			}
+		})
+
+		It("should be able to target a bookmark", func() {
+			t := target.NewTarget("", "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetBookmark(ctx, "golden")).To(Succeed())
+			assertTargetProvider(targetProvider, target.NewTarget(gardenName, prod1Project.Name, "", prod1GoldenShoot.Name).WithControlPlane(true))
+		})
+
+		It("should fail with unknown bookmark", func() {
+			t := target.NewTarget(gardenName, "", "", "")
+			manager, targetProvider := createTestManager(t, cfg, clientProvider)
+
+			Expect(manager.TargetBookmark(ctx, "does-not-exist")).NotTo(Succeed())
+			assertTargetProvider(targetProvider, t)
+		})
+
+		It("should return the bookmark names", func() {
+			manager, _ := createTestManager(target.NewTarget("", "", "", ""), cfg, clientProvider)
+
+			Expect(manager.BookmarkNames()).To(Equal([]string{"golden", "pending"}))
+		})
+	})
+
 	It("should provide a garden client", func() {
 		t := target.NewTarget(gardenName, "", "", "")
 		manager, _ := createTestManager(t, cfg, clientProvider)
@@ -39,6 +39,21 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// BookmarkNames mocks base method.
+func (m *MockManager) BookmarkNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookmarkNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookmarkNames indicates an expected call of BookmarkNames.
+func (mr *MockManagerMockRecorder) BookmarkNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookmarkNames", reflect.TypeOf((*MockManager)(nil).BookmarkNames))
+}
+
 // ClientConfig mocks base method.
 func (m *MockManager) ClientConfig(arg0 context.Context, arg1 target.Target) (clientcmd.ClientConfig, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +187,20 @@ func (mr *MockManagerMockRecorder) SessionDir() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionDir", reflect.TypeOf((*MockManager)(nil).SessionDir))
 }
 
+// SetTarget mocks base method.
+func (m *MockManager) SetTarget(arg0 context.Context, arg1 target.Target) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTarget", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTarget indicates an expected call of SetTarget.
+func (mr *MockManagerMockRecorder) SetTarget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTarget", reflect.TypeOf((*MockManager)(nil).SetTarget), arg0, arg1)
+}
+
 // ShootClient mocks base method.
 func (m *MockManager) ShootClient(arg0 context.Context, arg1 target.Target) (client.Client, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +231,34 @@ func (mr *MockManagerMockRecorder) ShootNames(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShootNames", reflect.TypeOf((*MockManager)(nil).ShootNames), arg0)
 }
 
+// TargetBack mocks base method.
+func (m *MockManager) TargetBack(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetBack", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TargetBack indicates an expected call of TargetBack.
+func (mr *MockManagerMockRecorder) TargetBack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetBack", reflect.TypeOf((*MockManager)(nil).TargetBack), arg0)
+}
+
+// TargetBookmark mocks base method.
+func (m *MockManager) TargetBookmark(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetBookmark", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TargetBookmark indicates an expected call of TargetBookmark.
+func (mr *MockManagerMockRecorder) TargetBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetBookmark", reflect.TypeOf((*MockManager)(nil).TargetBookmark), arg0, arg1)
+}
+
 // TargetControlPlane mocks base method.
 func (m *MockManager) TargetControlPlane(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -216,6 +273,20 @@ func (mr *MockManagerMockRecorder) TargetControlPlane(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetControlPlane", reflect.TypeOf((*MockManager)(nil).TargetControlPlane), arg0)
 }
 
+// TargetForward mocks base method.
+func (m *MockManager) TargetForward(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetForward", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TargetForward indicates an expected call of TargetForward.
+func (mr *MockManagerMockRecorder) TargetForward(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetForward", reflect.TypeOf((*MockManager)(nil).TargetForward), arg0)
+}
+
 // TargetGarden mocks base method.
 func (m *MockManager) TargetGarden(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -230,6 +301,21 @@ func (mr *MockManagerMockRecorder) TargetGarden(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetGarden", reflect.TypeOf((*MockManager)(nil).TargetGarden), arg0, arg1)
 }
 
+// TargetHistory mocks base method.
+func (m *MockManager) TargetHistory() (*target.History, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetHistory")
+	ret0, _ := ret[0].(*target.History)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetHistory indicates an expected call of TargetHistory.
+func (mr *MockManagerMockRecorder) TargetHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetHistory", reflect.TypeOf((*MockManager)(nil).TargetHistory))
+}
+
 // TargetMatchPattern mocks base method.
 func (m *MockManager) TargetMatchPattern(arg0 context.Context, arg1 target.TargetFlags, arg2 string) error {
 	m.ctrl.T.Helper()